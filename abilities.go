@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+	"github.com/OferRavid/pokedexcli/internal/render"
+)
+
+/*
+commandAbility displays what an ability does and lists the Pokemon that can
+have it, marking those that only have it as a hidden ability.
+*/
+func commandAbility(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 1 {
+		return messages.Errorf("you must provide an ability name")
+	}
+
+	name := toSlug(args[0])
+	ability, err := cfg.pokeapiClient.GetAbility(name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointAbility, name, err)
+	}
+
+	effect := localizedEffect(ability.EffectEntries, cfg.language)
+	record := abilityRecord{
+		ID:          ability.ID,
+		Name:        ability.Name,
+		Generation:  ability.Generation.Name,
+		ShortEffect: effect.ShortEffect,
+		Effect:      effect.Effect,
+		FlavorText:  flavorText(ability.FlavorTextEntries, cfg.language, cfg.game),
+		Pokemon:     []abilityPokemonRecord{},
+	}
+	for _, p := range ability.Pokemon {
+		record.Pokemon = append(record.Pokemon, abilityPokemonRecord{Name: p.Pokemon.Name, Hidden: p.IsHidden})
+	}
+
+	return printRecords(cfg, record, func() {
+		ui := cfg.ui
+		fmt.Println(ui.Bold(cfg.msg.Sprintf("Ability: %s", resourceName(cfg, ability.Names, record.Name))))
+		cfg.msg.Printf("Generation: %s\n", record.Generation)
+		if record.ShortEffect != "" {
+			cfg.msg.Printf("Effect: %s\n", record.ShortEffect)
+		}
+		if record.FlavorText != "" {
+			cfg.msg.Printf("Description: %s\n", record.FlavorText)
+		}
+		if record.Effect != "" && record.Effect != record.ShortEffect {
+			fmt.Printf("\n%s\n\n", record.Effect)
+		}
+		if len(record.Pokemon) == 0 {
+			fmt.Println(cfg.msg.Sprintf("No Pokemon have this ability."))
+			return
+		}
+		names := []string{}
+		for _, p := range record.Pokemon {
+			names = append(names, p.Name)
+		}
+		prefetchNames(cfg, pokeapi.EndpointPokemonSpecies, names)
+
+		rows := [][]string{}
+		for _, p := range record.Pokemon {
+			hidden := ""
+			if p.Hidden {
+				hidden = cfg.msg.Sprintf("hidden")
+			}
+			rows = append(rows, []string{pokemonName(cfg, p.Name), hidden})
+		}
+		ui.Table([]string{cfg.msg.Sprintf("Pokemon"), ""}, rows)
+	})
+}
+
+/*
+localizedEffect returns the effect description in the given language, or in
+the default language when there is none. Whitespace is collapsed, since the
+PokeAPI texts are wrapped for the games' text boxes.
+*/
+func localizedEffect(entries []pokeapi.VerboseEffect, language string) pokeapi.VerboseEffect {
+	found := pokeapi.VerboseEffect{}
+	for _, entry := range entries {
+		if entry.Language.Name == language || (entry.Language.Name == defaultLanguage && found.Language.Name == "") {
+			found = entry
+		}
+	}
+	found.Effect = strings.Join(strings.Fields(found.Effect), " ")
+	found.ShortEffect = strings.Join(strings.Fields(found.ShortEffect), " ")
+	return found
+}
+
+// pokemonAbilities returns the names of a Pokemon's abilities in slot order,
+// separating its hidden abilities from the regular ones.
+func pokemonAbilities(pokemon pokeapi.Pokemon) (abilities, hidden []string) {
+	abilities, hidden = []string{}, []string{}
+	for _, a := range pokemon.Abilities {
+		if a.IsHidden {
+			hidden = append(hidden, a.Ability.Name)
+		} else {
+			abilities = append(abilities, a.Ability.Name)
+		}
+	}
+	return abilities, hidden
+}
+
+// printAbilities prints a Pokemon's abilities on one line, hidden ones last and marked.
+func printAbilities(cfg *config, pokemon pokeapi.Pokemon) {
+	abilities, hidden := pokemonAbilities(pokemon)
+	names := displayNames(cfg, pokeapi.EndpointAbility, abilities)
+	for _, name := range displayNames(cfg, pokeapi.EndpointAbility, hidden) {
+		names = append(names, cfg.ui.Colorize(render.Gray, cfg.msg.Sprintf("%s (hidden)", name)))
+	}
+	if len(names) > 0 {
+		cfg.msg.Printf("Abilities: %s\n", strings.Join(names, ", "))
+	}
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+func TestLocalizedEffect(t *testing.T) {
+	entries := []pokeapi.VerboseEffect{
+		{ShortEffect: "Peut paralyser.", Language: pokeapi.Resource{Name: "fr"}},
+		{ShortEffect: "Has a 30% chance\nto paralyze attacking Pokémon.", Language: pokeapi.Resource{Name: "en"}},
+		{ShortEffect: "Kann paralysieren.", Language: pokeapi.Resource{Name: "de"}},
+	}
+	cases := []struct {
+		language string
+		expected string
+	}{
+		{language: "fr", expected: "Peut paralyser."},
+		{language: "de", expected: "Kann paralysieren."},
+		{language: "en", expected: "Has a 30% chance to paralyze attacking Pokémon."},
+		{language: "ja", expected: "Has a 30% chance to paralyze attacking Pokémon."},
+	}
+	for _, c := range cases {
+		if actual := localizedEffect(entries, c.language).ShortEffect; actual != c.expected {
+			t.Errorf("localizedEffect(%s): expected %q, got %q", c.language, c.expected, actual)
+		}
+	}
+}
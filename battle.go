@@ -0,0 +1,220 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+const (
+	// attackPower is the power of every attack in a battle.
+	attackPower = 40
+	// maxTurns ends battles in which neither Pokemon can hurt the other.
+	maxTurns = 100
+)
+
+// battler is a Pokemon taking part in a battle.
+type battler struct {
+	pokemon *game.Pokemon
+	species pokeapi.Pokemon
+	name    string
+}
+
+/*
+commandBattle fights the wild Pokemon met with the encounter command, using
+the first party Pokemon that can still fight or the one given. The Pokemon
+take turns attacking, the faster one first, until one of them faints.
+Winning ends the encounter and earns money, experience and effort values; losing
+leaves the wild Pokemon there, hurt, for another Pokemon to fight or catch.
+*/
+func commandBattle(cfg *config, flags flagSet, args ...string) error {
+	if len(args) > 1 {
+		return messages.Errorf("usage: battle [pokemon]")
+	}
+	wild := cfg.wildPokemon
+	if wild == nil {
+		return messages.Errorf("there's no wild Pokemon to battle. use the encounter command to find one")
+	}
+
+	var lead *game.Pokemon
+	if len(args) == 1 {
+		p, box, err := findOwned(cfg, args[0])
+		if err != nil {
+			return err
+		}
+		if box > 0 {
+			return messages.Errorf("%s is in box %d. withdraw it to battle", p.Name(), box)
+		}
+		if p.CurrentHP == 0 {
+			return messages.Errorf("%s has fainted and can't battle", p.Name())
+		}
+		lead = p
+	} else if lead = leadPokemon(cfg); lead == nil {
+		return messages.Errorf("you have no Pokemon that can battle")
+	}
+
+	leadSpecies, err := speciesData(cfg, lead.Species)
+	if err != nil {
+		return err
+	}
+	wildSpecies, err := speciesData(cfg, wild.name)
+	if err != nil {
+		return err
+	}
+	foe, err := wildIndividual(cfg, wild, wildSpecies)
+	if err != nil {
+		return err
+	}
+
+	player := battler{pokemon: lead, species: leadSpecies, name: lead.Name()}
+	opponent := battler{pokemon: foe, species: wildSpecies, name: cfg.msg.Sprintf("wild %s", wild.name)}
+	cfg.msg.Printf("Go, %s! (level %d, %d/%d HP)\n", player.name, lead.Level, lead.CurrentHP, lead.MaxHP(leadSpecies))
+
+	first, second := &player, &opponent
+	playerSpeed, wildSpeed := lead.Stats(leadSpecies)["speed"], foe.Stats(wildSpecies)["speed"]
+	if wildSpeed > playerSpeed || (wildSpeed == playerSpeed && cfg.rng.Intn(2) == 0) {
+		first, second = second, first
+	}
+	for turn := 0; turn < maxTurns && lead.CurrentHP > 0 && foe.CurrentHP > 0; turn++ {
+		attack(cfg, first, second)
+		if second.pokemon.CurrentHP > 0 {
+			attack(cfg, second, first)
+		}
+	}
+
+	switch {
+	case foe.CurrentHP == 0:
+		prize := game.BattlePrize(wild.level)
+		cfg.money += prize
+		cfg.msg.Printf("The wild %s fainted! You found %s.\n", wild.name, formatMoney(prize))
+		cfg.wildPokemon = nil
+		lead.GainEVs(wildSpecies)
+		return awardXP(cfg, lead, leadSpecies, game.XPYield(wildSpecies, wild.level))
+	case lead.CurrentHP == 0:
+		cfg.msg.Printf("%s fainted! The wild %s is still here.\n", lead.Name(), wild.name)
+	default:
+		fmt.Println(cfg.msg.Sprintf("Neither Pokemon could win. The battle is over."))
+	}
+	return nil
+}
+
+// attack makes the attacker hit the defender with its stronger kind of attack.
+func attack(cfg *config, attacker, defender *battler) {
+	attackerStats := attacker.pokemon.Stats(attacker.species)
+	defenderStats := defender.pokemon.Stats(defender.species)
+	attackStat, defenseStat := attackerStats["attack"], defenderStats["defense"]
+	if attackerStats["special-attack"] > attackStat {
+		attackStat, defenseStat = attackerStats["special-attack"], defenderStats["special-defense"]
+	}
+
+	damage := game.Damage(attacker.pokemon.Level, attackPower, attackStat, defenseStat, cfg.rng)
+	defender.pokemon.CurrentHP = max(0, defender.pokemon.CurrentHP-damage)
+	cfg.msg.Printf("%s attacks %s for %d damage. (%d/%d HP left)\n",
+		attacker.name, defender.name, damage, defender.pokemon.CurrentHP, defender.pokemon.MaxHP(defender.species))
+}
+
+// leadPokemon returns the first party Pokemon that hasn't fainted, or nil if there is none.
+func leadPokemon(cfg *config) *game.Pokemon {
+	for _, p := range cfg.storage.Party {
+		if p.CurrentHP > 0 {
+			return p
+		}
+	}
+	return nil
+}
+
+// speciesData returns the PokeAPI data of a Pokemon, from the caught Pokemon when possible.
+// Caught Pokemon that couldn't be fetched when the game was loaded are kept once they are.
+func speciesData(cfg *config, name string) (pokeapi.Pokemon, error) {
+	if pokemon, ok := cfg.caughtPokemon[name]; ok {
+		return pokemon, nil
+	}
+	pokemon, err := cfg.pokeapiClient.GetPokemon(name)
+	if err != nil {
+		return pokeapi.Pokemon{}, notFoundError(cfg, pokeapi.EndpointPokemon, name, err)
+	}
+	if i := slices.Index(cfg.unloadedCaught, name); i >= 0 {
+		cfg.unloadedCaught = slices.Delete(cfg.unloadedCaught, i, i+1)
+		cfg.caughtPokemon[name] = pokemon
+	}
+	return pokemon, nil
+}
+
+// wildIndividual returns the wild Pokemon's stats and HP, creating them the first time it is met.
+func wildIndividual(cfg *config, wild *wildPokemon, species pokeapi.Pokemon) (*game.Pokemon, error) {
+	if wild.individual == nil {
+		nature, err := randomNature(cfg)
+		if err != nil {
+			return nil, err
+		}
+		wild.individual = game.NewPokemon(species, wild.level, wild.area, nature, cfg.rng)
+	}
+	return wild.individual, nil
+}
+
+// growthRate returns the growth rate of a Pokemon's species.
+func growthRate(cfg *config, pokemon pokeapi.Pokemon) (pokeapi.GrowthRate, error) {
+	species, err := cfg.pokeapiClient.GetPokemonSpecies(pokemon.Species.Name)
+	if err != nil {
+		return pokeapi.GrowthRate{}, err
+	}
+	return cfg.pokeapiClient.GetGrowthRate(species.GrowthRate.Name)
+}
+
+/*
+awardXP gives experience to an owned Pokemon and announces the levels it
+reaches, teaching it the moves learned at each of them.
+*/
+func awardXP(cfg *config, p *game.Pokemon, species pokeapi.Pokemon, xp int) error {
+	rate, err := growthRate(cfg, species)
+	if err != nil {
+		return err
+	}
+	cfg.msg.Printf("%s gained %d XP.\n", p.Name(), xp)
+	for _, level := range p.GainXP(xp, rate, species) {
+		cfg.msg.Printf("%s grew to level %d!\n", p.Name(), level)
+		for _, move := range game.MovesLearnedAt(species, level) {
+			learnMove(cfg, p, move)
+		}
+	}
+	return nil
+}
+
+/*
+learnMove teaches a Pokemon a move. When it already knows MaxMoves moves, the
+player is asked which one to forget, or may give up on the new move.
+*/
+func learnMove(cfg *config, p *game.Pokemon, move string) {
+	if p.Knows(move) {
+		return
+	}
+	if len(p.Moves) < game.MaxMoves {
+		p.Moves = append(p.Moves, move)
+		cfg.msg.Printf("%s learned %s!\n", p.Name(), move)
+		return
+	}
+
+	cfg.msg.Printf("%s wants to learn %s, but it already knows %d moves:\n", p.Name(), move, len(p.Moves))
+	for i, known := range p.Moves {
+		fmt.Printf("  %d. %s\n", i+1, known)
+	}
+	for {
+		answer, ok := prompt(cfg, cfg.msg.Sprintf("Which move should be forgotten? (1-%d, or press enter to keep them) ", len(p.Moves)))
+		if !ok || answer == "" {
+			cfg.msg.Printf("%s did not learn %s.\n", p.Name(), move)
+			return
+		}
+		n, err := strconv.Atoi(answer)
+		if err != nil || n < 1 || n > len(p.Moves) {
+			cfg.msg.Printf("Choose a number from 1 to %d.\n", len(p.Moves))
+			continue
+		}
+		cfg.msg.Printf("1, 2, and... Poof! %s forgot %s and learned %s!\n", p.Name(), p.Moves[n-1], move)
+		p.Moves[n-1] = move
+		return
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"bufio"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+)
+
+func TestLearnMove(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected []string
+	}{
+		{input: "", expected: []string{"a", "b", "c", "d"}},
+		{input: "\n", expected: []string{"a", "b", "c", "d"}},
+		{input: "2\n", expected: []string{"a", "e", "c", "d"}},
+		{input: "nine\n7\n4\n", expected: []string{"a", "b", "c", "e"}},
+	}
+	for _, c := range cases {
+		cfg := &config{input: bufio.NewScanner(strings.NewReader(c.input))}
+		p := &game.Pokemon{Species: "pikachu", Moves: []string{"a", "b", "c", "d"}}
+		learnMove(cfg, p, "e")
+		if !slices.Equal(p.Moves, c.expected) {
+			t.Errorf("input %q: expected moves %v, got %v", c.input, c.expected, p.Moves)
+		}
+	}
+
+	p := &game.Pokemon{Species: "pikachu", Moves: []string{"a"}}
+	learnMove(&config{}, p, "b")
+	learnMove(&config{}, p, "b")
+	if !slices.Equal(p.Moves, []string{"a", "b"}) {
+		t.Errorf("expected the move to be learned once without asking, got %v", p.Moves)
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+/*
+commandPlant plants a berry from the bag in a free soil plot at the explored
+location. It grows in real time and can be harvested when ripe.
+*/
+func commandPlant(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 1 {
+		return messages.Errorf("usage: plant <berry>")
+	}
+	location, err := exploredLocation(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Berries are named "oran" by the berry endpoint and "oran-berry" as items.
+	name := strings.TrimSuffix(toSlug(args[0]), "-berry")
+	berry, err := cfg.pokeapiClient.GetBerry(name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointBerry, name, err)
+	}
+	if cfg.bag[berry.Item.Name] == 0 {
+		return messages.Errorf("you don't have any %s to plant", berry.Item.Name)
+	}
+
+	plot, err := cfg.farm.Plant(location, berry, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := cfg.bag.Remove(berry.Item.Name, 1); err != nil {
+		return err
+	}
+	cfg.msg.Printf("You planted %s in %s. It will be ripe in %s.\n", berry.Item.Name, location, formatDuration(time.Until(plot.RipeAt())))
+	return nil
+}
+
+/*
+commandHarvest picks the ripe berries at the explored location and puts them
+in the bag.
+*/
+func commandHarvest(cfg *config, flags flagSet, args ...string) error {
+	location, err := exploredLocation(cfg)
+	if err != nil {
+		return err
+	}
+	if len(cfg.farm.At(location)) == 0 {
+		return messages.Errorf("nothing is planted in %s. plant a berry with the plant command", location)
+	}
+
+	picked := cfg.farm.Harvest(location, time.Now(), cfg.rng)
+	if len(picked) == 0 {
+		return messages.Errorf("none of the berries in %s are ripe yet. check them with the plots command", location)
+	}
+	for _, item := range picked.Items() {
+		cfg.bag.Add(item, picked[item])
+		cfg.msg.Printf("You picked %d %s!\n", picked[item], item)
+	}
+	return nil
+}
+
+/*
+commandPlots displays every berry the player has planted, with its growth
+stage and the time left until it is ripe.
+*/
+func commandPlots(cfg *config, flags flagSet, args ...string) error {
+	if len(cfg.farm.Plots) == 0 {
+		return messages.Errorf("you haven't planted any berries")
+	}
+
+	now := time.Now()
+	records := []plotRecord{}
+	for _, p := range cfg.farm.Plots {
+		records = append(records, plotRecord{
+			Location:  p.Location,
+			Berry:     p.Item,
+			Stage:     game.BerryStages[p.Stage(now)],
+			PlantedAt: p.PlantedAt,
+			RipeAt:    p.RipeAt(),
+		})
+	}
+	return printRecords(cfg, records, func() {
+		rows := [][]string{}
+		for _, r := range records {
+			ripe := cfg.msg.Sprintf("now")
+			if left := r.RipeAt.Sub(now); left > 0 {
+				ripe = cfg.msg.Sprintf("in %s", formatDuration(left))
+			}
+			rows = append(rows, []string{r.Location, r.Berry, r.Stage, ripe})
+		}
+		cfg.ui.Table([]string{cfg.msg.Sprintf("Location"), cfg.msg.Sprintf("Berry"), cfg.msg.Sprintf("Stage"), cfg.msg.Sprintf("Ripe")}, rows)
+	})
+}
+
+// formatDuration writes a duration in hours and minutes, e.g. "3h 20m".
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	hours, minutes := int(d.Hours()), int(d.Minutes())%60
+	if hours == 0 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %dm", hours, minutes)
+}
@@ -1,24 +1,39 @@
 package main
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
-	"math/rand"
 	"os"
 	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/output"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+	"github.com/OferRavid/pokedexcli/internal/render"
+)
+
+const (
+	// maxBaseStat is the highest base stat of any Pokemon (Blissey's HP),
+	// used as the full length of stat bars.
+	maxBaseStat  = 255
+	statBarWidth = 30
 )
 
 /*
 commandHelp prints a help message displaying available commands and their descriptions.
 It takes a config struct but does not use it.
 */
-func commandHelp(cfg *config, args ...string) error {
+func commandHelp(cfg *config, flags flagSet, args ...string) error {
 	fmt.Println()
-	fmt.Println("Welcome to the Pokedex!")
-	fmt.Println("Usage:")
+	fmt.Println(cfg.msg.Sprintf("Welcome to the Pokedex!"))
+	fmt.Println(cfg.msg.Sprintf("Usage:"))
 	fmt.Println()
 	for _, cmd := range getCommands() {
-		fmt.Printf("%s: %s\n", cmd.name, cmd.description)
+		fmt.Printf("%s: %s\n", cmd.name, cfg.msg.Translate(cmd.description))
 	}
 	fmt.Println()
 	return nil
@@ -28,154 +43,774 @@ func commandHelp(cfg *config, args ...string) error {
 commandExit prints a farewell message and exits the program.
 It does not return an error.
 */
-func commandExit(cfg *config, args ...string) error {
-	fmt.Println("Closing the Pokedex... Goodbye!")
+func commandExit(cfg *config, flags flagSet, args ...string) error {
+	if err := saveGame(cfg); err != nil {
+		return messages.Errorf("can't save the game: %w", err)
+	}
+	fmt.Println(cfg.msg.Sprintf("Closing the Pokedex... Goodbye!"))
 	os.Exit(0)
 	return nil
 }
 
 /*
-commandMap retrieves and prints the next batch of location areas using the PokeAPI client.
-It updates the configuration with new pagination URLs.
+commandMap retrieves and prints a page of location areas using the PokeAPI client.
+Without arguments it shows the next page; "map <page>", "map first" and
+"map last" jump directly to a page, and --limit changes the page size.
+It updates the configuration with the page that was shown.
 */
-func commandMap(cfg *config, args ...string) error {
-	locationsResp, err := cfg.pokeapiClient.ListLocations(cfg.NextLocationsURL)
+func commandMap(cfg *config, flags flagSet, args ...string) error {
+	limit, err := flags.getInt("limit", cfg.locationsLimit)
 	if err != nil {
 		return err
 	}
+	if limit < 1 {
+		return messages.Errorf("the limit must be at least 1")
+	}
+	if len(args) > 1 {
+		return messages.Errorf("usage: map [page|first|last] [--limit N]")
+	}
+	cfg.locationsLimit = limit
 
-	cfg.NextLocationsURL = locationsResp.Next
-	cfg.PreviousLocationsURL = locationsResp.Previous
+	offset := 0
+	if len(args) == 0 {
+		if cfg.locationsOffset != nil {
+			// The limit may have changed, so the next page starts after the one
+			// holding the current offset at the new page size.
+			offset = (*cfg.locationsOffset/limit + 1) * limit
+		}
+		if cfg.locationsCount > 0 && offset >= cfg.locationsCount {
+			return messages.Errorf("you're on the last page")
+		}
+		return showLocationsPage(cfg, offset)
+	}
 
-	for _, loc := range locationsResp.Results {
-		fmt.Println(loc.Name)
+	switch args[0] {
+	case "first":
+	case "last":
+		if cfg.locationsCount == 0 {
+			// The total is unknown until a page has been loaded.
+			locationsResp, err := cfg.pokeapiClient.ListLocations(0, limit)
+			if err != nil {
+				return err
+			}
+			cfg.locationsCount = locationsResp.Count
+		}
+		offset = (pageCount(cfg.locationsCount, limit) - 1) * limit
+	default:
+		page, err := strconv.Atoi(args[0])
+		if err != nil || page < 1 {
+			return messages.Errorf("invalid page %q: use a page number, first or last", args[0])
+		}
+		offset = (page - 1) * limit
 	}
-	return nil
+	return showLocationsPage(cfg, offset)
 }
 
 /*
-commandMapb retrieves and prints the previous batch of location areas using the PokeAPI client.
-It updates the configuration with new pagination URLs.
+commandMapb retrieves and prints the previous page of location areas using the PokeAPI client.
+It updates the configuration with the page that was shown.
 */
-func commandMapb(cfg *config, args ...string) error {
-	if cfg.PreviousLocationsURL == nil {
-		return errors.New("you're on the first page")
+func commandMapb(cfg *config, flags flagSet, args ...string) error {
+	if cfg.locationsOffset == nil || *cfg.locationsOffset == 0 {
+		return messages.Errorf("you're on the first page")
 	}
 
-	locationResp, err := cfg.pokeapiClient.ListLocations(cfg.PreviousLocationsURL)
+	return showLocationsPage(cfg, max(0, *cfg.locationsOffset-cfg.locationsLimit))
+}
+
+/*
+showLocationsPage loads the page of location areas starting at offset, prints
+it and remembers it as the current page. Pages past the end are rejected.
+*/
+func showLocationsPage(cfg *config, offset int) error {
+	locationsResp, err := cfg.pokeapiClient.ListLocations(offset, cfg.locationsLimit)
 	if err != nil {
 		return err
 	}
+	cfg.locationsCount = locationsResp.Count
+
+	pages := pageCount(locationsResp.Count, cfg.locationsLimit)
+	if len(locationsResp.Results) == 0 && offset > 0 {
+		return messages.Errorf("page %d doesn't exist, there are %d pages", offset/cfg.locationsLimit+1, pages)
+	}
+
+	cfg.locationsOffset = &offset
+	return printLocations(cfg, locationsResp, offset/cfg.locationsLimit+1, pages)
+}
 
-	cfg.NextLocationsURL = locationResp.Next
-	cfg.PreviousLocationsURL = locationResp.Previous
+// pageCount returns the number of pages needed to show count results.
+func pageCount(count, limit int) int {
+	return max(1, (count+limit-1)/limit)
+}
 
-	for _, loc := range locationResp.Results {
-		fmt.Println(loc.Name)
+/*
+printLocations prints a page of location areas in the configured output format,
+followed by a pagination footer in the table format.
+*/
+func printLocations(cfg *config, locations pokeapi.LocationAreaList, page, pages int) error {
+	records := []locationRecord{}
+	for _, loc := range locations.Results {
+		records = append(records, locationRecord{Name: loc.Name, URL: loc.URL})
 	}
-	return nil
+	return printRecords(cfg, records, func() {
+		names := []string{}
+		for _, loc := range records {
+			names = append(names, loc.Name)
+		}
+		prefetchNames(cfg, pokeapi.EndpointLocationArea, names)
+		for _, loc := range records {
+			fmt.Println(labeledName(cfg, pokeapi.EndpointLocationArea, loc.Name))
+		}
+		fmt.Println(cfg.ui.Colorize(render.Gray, cfg.msg.Sprintf("page %d of %d (%d areas)", page, pages, locations.Count)))
+	})
 }
 
 /*
 commandExplore retrieves and displays the Pokemon encountered in a specified location.
-It updates the explored area in the configuration.
+The name may be a location-area, or a location whose areas are all explored.
+--version and --method limit the encounters to one game and one encounter method,
+and --details shows the level range and chance of every encounter.
+It updates the explored area in the configuration and marks the Pokemon found
+as seen in the pokedex.
 */
-func commandExplore(cfg *config, args ...string) error {
+func commandExplore(cfg *config, flags flagSet, args ...string) error {
 	if len(args) != 1 {
-		return errors.New("you must provide a location name")
+		return messages.Errorf("you must provide a location name")
 	}
+	versions := versionFilter(cfg, flags)
+	method := toSlug(flags.get("method"))
 
-	name := args[0]
-	location, err := cfg.pokeapiClient.GetLocation(name)
+	name := toSlug(args[0])
+	areas, err := locationAreas(cfg, name)
 	if err != nil {
 		return err
 	}
-	fmt.Printf("Exploring %s...\n", location.Name)
-	fmt.Println("Found Pokemon: ")
-	cfg.areaExplored = []string{}
-	cfg.areaExplored = append(cfg.areaExplored, location.Name)
-	for _, enc := range location.PokemonEncounters {
-		name := enc.Pokemon.Name
-		fmt.Printf(" - %s\n", name)
-		cfg.areaExplored = append(cfg.areaExplored, name)
+
+	cfg.locationExplored = name
+	cfg.areasExplored = areas
+	cfg.wildPokemon = nil
+	records := []encounterRecord{}
+	detailRecords := []encounterDetailRecord{}
+	for _, area := range areas {
+		for _, slot := range encounterSlots(area, versions, method) {
+			cfg.pokedex.See(slot.pokemon, pokeapi.ResourceID(slot.url))
+			detailRecords = append(detailRecords, newEncounterDetailRecord(area.Name, slot))
+			if slices.ContainsFunc(records, func(r encounterRecord) bool {
+				return r.Location == area.Name && r.Pokemon == slot.pokemon
+			}) {
+				continue
+			}
+			records = append(records, encounterRecord{
+				Location: area.Name,
+				Pokemon:  slot.pokemon,
+				URL:      slot.url,
+			})
+		}
+	}
+
+	if flags.has("details") {
+		return printRecords(cfg, detailRecords, func() {
+			for _, area := range areas {
+				printEncounterDetails(cfg, area, versions, method)
+			}
+		})
+	}
+	return printRecords(cfg, records, func() {
+		names := []string{}
+		for _, enc := range records {
+			names = append(names, enc.Pokemon)
+		}
+		prefetchNames(cfg, pokeapi.EndpointPokemonSpecies, names)
+		for _, area := range areas {
+			cfg.msg.Printf("Exploring %s...\n", resourceName(cfg, area.Names, area.Name))
+			fmt.Println(cfg.msg.Sprintf("Found Pokemon: "))
+			rows := [][]string{}
+			for _, enc := range records {
+				if enc.Location == area.Name {
+					rows = append(rows, []string{strconv.Itoa(len(rows) + 1), pokemonName(cfg, enc.Pokemon)})
+				}
+			}
+			cfg.ui.Table([]string{"#", cfg.msg.Sprintf("Pokemon")}, rows)
+		}
+	})
+}
+
+/*
+printEncounterDetails prints a table of the encounters in an area with their
+version, method, level range and chance, followed by the encounter rate of
+each method.
+*/
+func printEncounterDetails(cfg *config, area pokeapi.LocationArea, versions []string, method string) {
+	cfg.msg.Printf("Exploring %s...\n", resourceName(cfg, area.Names, area.Name))
+	slots := encounterSlots(area, versions, method)
+	names := []string{}
+	for _, slot := range slots {
+		names = append(names, slot.pokemon)
+	}
+	prefetchNames(cfg, pokeapi.EndpointPokemonSpecies, names)
+
+	rows := [][]string{}
+	for _, slot := range slots {
+		rows = append(rows, []string{pokemonName(cfg, slot.pokemon), slot.version, slot.method, slot.levelRange(), fmt.Sprintf("%d%%", slot.chance)})
+	}
+	if len(rows) == 0 {
+		fmt.Println(cfg.msg.Sprintf("No Pokemon found."))
+		return
+	}
+	cfg.ui.Table([]string{cfg.msg.Sprintf("Pokemon"), cfg.msg.Sprintf("Version"), cfg.msg.Sprintf("Method"), cfg.msg.Sprintf("Levels"), cfg.msg.Sprintf("Chance")}, rows)
+
+	rateRows := [][]string{}
+	for _, rate := range methodRates(area, versions, method) {
+		rateRows = append(rateRows, []string{rate.method, rate.version, fmt.Sprintf("%d%%", rate.rate)})
+	}
+	if len(rateRows) > 0 {
+		fmt.Println()
+		cfg.ui.Table([]string{cfg.msg.Sprintf("Method"), cfg.msg.Sprintf("Version"), cfg.msg.Sprintf("Encounter rate")}, rateRows)
+	}
+	fmt.Println()
+}
+
+/*
+locationAreas returns the location-area with the given name, or all areas of
+the location with that name when there is no such area.
+*/
+func locationAreas(cfg *config, name string) ([]pokeapi.LocationArea, error) {
+	area, err := cfg.pokeapiClient.GetLocation(name)
+	if err == nil {
+		return []pokeapi.LocationArea{area}, nil
+	}
+	if !errors.Is(err, pokeapi.ErrNotFound) {
+		return nil, err
 	}
 
+	location, err := cfg.pokeapiClient.GetLocationByName(name)
+	if err != nil {
+		return nil, notFoundError(cfg, pokeapi.EndpointLocationArea, name, err)
+	}
+	if len(location.Areas) == 0 {
+		return nil, messages.Errorf("%s has no areas to explore", location.Name)
+	}
+
+	areas := []pokeapi.LocationArea{}
+	for _, areaRef := range location.Areas {
+		area, err := cfg.pokeapiClient.GetLocation(areaRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		areas = append(areas, area)
+	}
+	return areas, nil
+}
+
+// exploredLocation returns the name of the location explored last, which the
+// player is considered to be at.
+func exploredLocation(cfg *config) (string, error) {
+	if len(cfg.areasExplored) == 0 {
+		return "", messages.Errorf("you aren't anywhere yet. explore a location first")
+	}
+	return cfg.areasExplored[0].Location.Name, nil
+}
+
+/*
+commandEncounter looks for a wild Pokemon in the explored area using an
+encounter method: walk (the default), surf, or fish with a rod.
+The Pokemon is drawn using the area's encounter chances and becomes the one
+that can be caught.
+*/
+func commandEncounter(cfg *config, flags flagSet, args ...string) error {
+	if len(cfg.areasExplored) == 0 {
+		return messages.Errorf("you must explore an area for Pokemon encounters first")
+	}
+
+	method := "walk"
+	switch {
+	case len(args) == 0:
+	case toSlug(args[0]) == "fish" && len(args) <= 2:
+		method = "old-rod"
+		if len(args) == 2 {
+			method = toSlug(args[1])
+			if !strings.HasSuffix(method, "-rod") {
+				method += "-rod"
+			}
+		}
+	case len(args) == 1:
+		method = toSlug(args[0])
+	default:
+		return messages.Errorf("usage: encounter [walk|surf|fish <old-rod|good-rod|super-rod>]")
+	}
+
+	wild, ok := rollEncounter(cfg.areasExplored, method, cfg.game.versions, cfg.rng)
+	if !ok {
+		return messages.Errorf("no Pokemon can be encountered by %s in %s. try: %s",
+			method, cfg.locationExplored, strings.Join(encounterMethods(cfg.areasExplored), ", "))
+	}
+
+	cfg.wildPokemon = &wild
+	cfg.pokedex.See(wild.name, wild.number)
+	cfg.msg.Printf("A wild %s (level %d) appeared in %s!\n",
+		pokemonName(cfg, wild.name), wild.level, displayName(cfg, pokeapi.EndpointLocationArea, wild.area))
+	fmt.Println(cfg.msg.Sprintf("Throw a Pokeball at it with the catch command."))
 	return nil
 }
 
 /*
-commandCatch attempts to catch a specified Pokemon encountered in an explored area.
-It checks if the Pokemon was encountered before allowing the capture attempt.
+commandCatch attempts to catch the wild Pokemon met with the encounter command.
+Whether it is caught or escapes, the encounter ends. Each attempt uses up a
+ball from the bag, a Poke Ball unless another is chosen with --ball; better
+balls make a catch more likely. A successful catch gives the lead party
+Pokemon experience.
 */
-func commandCatch(cfg *config, args ...string) error {
-	if len(args) != 1 {
-		return errors.New("you must provide a pokemon name")
+func commandCatch(cfg *config, flags flagSet, args ...string) error {
+	if len(args) > 1 {
+		return messages.Errorf("usage: catch [pokemon_name] [--ball <ball>]")
+	}
+	wild := cfg.wildPokemon
+	if wild == nil {
+		return messages.Errorf("there's no wild Pokemon to catch. use the encounter command to find one")
 	}
-	name := args[0]
-	if len(cfg.areaExplored) == 0 {
-		return errors.New("you must explore an area for Pokemon encounters first")
+	if len(args) == 1 {
+		if name := toSlug(args[0]); name != wild.name {
+			return messages.Errorf("there's no wild %s here. you encountered %s", name, wild.name)
+		}
 	}
-	if ok := slices.Contains(cfg.areaExplored, name); !ok {
-		return fmt.Errorf("you didn't encounter %s in %s.\nexplore %s again to see the Pokemon encountered", name, cfg.areaExplored[0], cfg.areaExplored[0])
+
+	ball := "poke-ball"
+	if flags.has("ball") {
+		ball = toSlug(flags.get("ball"))
 	}
-	pokemon, err := cfg.pokeapiClient.GetPokemon(name)
+	if cfg.bag[ball] == 0 {
+		return messages.Errorf("you don't have any %s. check your bag with the bag command", ball)
+	}
+	item, err := cfg.pokeapiClient.GetItem(ball)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointItem, ball, err)
+	}
+	if !game.IsBall(item.Category.Name) {
+		return messages.Errorf("%s is not a Poke Ball", ball)
+	}
+
+	pokemon, err := cfg.pokeapiClient.GetPokemon(wild.name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointPokemon, wild.name, err)
+	}
+	// Everything a catch needs is fetched before the ball is thrown, so a
+	// failed request keeps the ball and the encounter.
+	owned, err := wildIndividual(cfg, wild, pokemon)
 	if err != nil {
 		return err
 	}
-	fmt.Printf("Throwing a Pokeball at %s...\n", pokemon.Name)
-	if success := rand.Intn(pokemon.BaseExperience) <= 40; success {
-		fmt.Printf("%s was caught!\n", pokemon.Name)
-		if _, ok := cfg.caughtPokemonCount[pokemon.Name]; ok {
-			cfg.caughtPokemonCount[pokemon.Name]++
+	rate, err := growthRate(cfg, pokemon)
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.bag.Remove(ball, 1); err != nil {
+		return err
+	}
+	cfg.wildPokemon = nil
+	name := pokemonName(cfg, pokemon.Name)
+	cfg.msg.Printf("Throwing a %s at %s...\n", displayName(cfg, pokeapi.EndpointItem, ball), name)
+	roll := float64(cfg.rng.Intn(max(1, pokemon.BaseExperience)))
+	if success := game.IsMasterBall(ball) || roll <= 40*game.BallModifier(ball); success {
+		cfg.msg.Printf("%s (level %d) was caught!\n", name, wild.level)
+		owned.XP = game.XPForLevel(rate, owned.Level)
+		lead := leadPokemon(cfg)
+		box, err := cfg.storage.Add(owned)
+		if err != nil {
+			return err
+		}
+		cfg.caughtPokemon[pokemon.Name] = pokemon
+		cfg.pokedex.See(pokemon.Name, pokemon.ID)
+		if box > 0 {
+			cfg.msg.Printf("Your party is full, so %s (ID %d) was sent to box %d.\n", name, owned.ID, box)
 		} else {
-			cfg.caughtPokemonCount[pokemon.Name] = 1
-			cfg.caughtPokemon[pokemon.Name] = pokemon
+			cfg.msg.Printf("%s (ID %d) joined your party.\n", name, owned.ID)
+		}
+		fmt.Println(cfg.msg.Sprintf("You may now inspect it with the inspect command."))
+		// Catching a Pokemon teaches the lead Pokemon as much as defeating it.
+		if lead != nil {
+			leadSpecies, err := speciesData(cfg, lead.Species)
+			if err != nil {
+				return err
+			}
+			return awardXP(cfg, lead, leadSpecies, game.XPYield(pokemon, wild.level))
 		}
-		fmt.Println("You may now inspect it with the inspect command.")
 	} else {
-		fmt.Printf("%s escaped!\n", pokemon.Name)
+		cfg.msg.Printf("%s escaped!\n", name)
 	}
 
 	return nil
 }
 
+// randomNature picks one of the natures at random, as the games do for wild Pokemon.
+func randomNature(cfg *config) (game.Nature, error) {
+	natures, err := cfg.pokeapiClient.ListResources(pokeapi.EndpointNature, 0, 100)
+	if err != nil {
+		return game.Nature{}, err
+	}
+	if len(natures.Results) == 0 {
+		return game.Nature{}, messages.Errorf("no natures found")
+	}
+	nature, err := cfg.pokeapiClient.GetNature(natures.Results[cfg.rng.Intn(len(natures.Results))].Name)
+	if err != nil {
+		return game.Nature{}, err
+	}
+	return game.NewNature(nature), nil
+}
+
+// Modes decide whether Pokemon that weren't caught can be looked at. In game
+// mode only caught Pokemon can, unless asked for with --any or lookup; in
+// reference mode every Pokemon can.
+const (
+	gameMode      = "game"
+	referenceMode = "reference"
+)
+
+// parseMode checks the name of a mode.
+func parseMode(name string) (string, error) {
+	switch mode := toSlug(name); mode {
+	case gameMode, referenceMode:
+		return mode, nil
+	default:
+		return "", messages.Errorf("unknown mode %q. choose game or reference", name)
+	}
+}
+
+// anyPokemon reports whether a command may show Pokemon that weren't caught.
+func anyPokemon(cfg *config, flags flagSet) bool {
+	return flags.has("any") || cfg.mode == referenceMode
+}
+
 /*
-commandInspect displays detailed information about a caught Pokemon.
-If the Pokemon has not been caught, it returns an error.
+commandInspect displays detailed information about an owned Pokemon, given
+by ID, nickname or species: its stats at its level next to the base stats of
+its species. Species that were caught but are no longer owned show their base
+stats only. Pokemon that weren't caught are only shown with --any or in
+reference mode, otherwise it returns an error.
 */
-func commandInspect(cfg *config, args ...string) error {
+func commandInspect(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 1 {
+		return messages.Errorf("you must provide a pokemon name")
+	}
+	name := toSlug(args[0])
+	if pokemon, ok := cfg.caughtPokemon[name]; ok && cfg.storage.Count(name) == 0 {
+		record := newPokemonRecord(pokemon, cfg.game.generation)
+		return printRecords(cfg, record, func() {
+			printPokemonDetails(cfg, pokemon)
+		})
+	}
+
+	// Numbers are IDs of owned Pokemon, never national dex numbers to look up.
+	p, _, err := findOwned(cfg, args[0])
+	_, convErr := strconv.Atoi(args[0])
+	if err != nil && convErr == nil {
+		return err
+	}
+	if err != nil && anyPokemon(cfg, flags) && cfg.storage.Count(name) == 0 {
+		return lookupPokemon(cfg, name)
+	}
+	if len(cfg.caughtPokemon) == 0 {
+		return messages.Errorf("can't show information on %s. you need to catch one first, or look it up with: lookup %s", name, name)
+	}
+	if err != nil {
+		return messages.Errorf("%w. look up any Pokemon with: lookup %s", err, name)
+	}
+	species, ok := cfg.caughtPokemon[p.Species]
+	if !ok {
+		return messages.Errorf("can't show information on %s. you need to catch one first", p.Species)
+	}
+	record := newIndividualRecord(p, species, cfg.game.generation)
+	return printRecords(cfg, record, func() {
+		printIndividualDetails(cfg, p, species)
+	})
+}
+
+/*
+printIndividualDetails prints an owned Pokemon's picture, level, nature,
+types and abilities, and a table of its stats next to its species' base stats, IVs and EVs.
+Stats raised by its nature are marked with + and lowered ones with -.
+*/
+func printIndividualDetails(cfg *config, p *game.Pokemon, species pokeapi.Pokemon) {
+	ui := cfg.ui
+	printSprite(cfg, species)
+	fmt.Printf("%s (%s #%d)\n", ui.Bold(cfg.msg.Sprintf("Name: %s", ownedName(cfg, p))), pokemonName(cfg, species.Name), species.ID)
+	cfg.msg.Printf("ID: %d\nLevel: %d (%d XP)\n", p.ID, p.Level, p.XP)
+	if p.Nature.Increased != "" {
+		cfg.msg.Printf("Nature: %s (+%s, -%s)\n", p.Nature.Name, p.Nature.Increased, p.Nature.Decreased)
+	} else {
+		cfg.msg.Printf("Nature: %s\n", p.Nature.Name)
+	}
+	cfg.msg.Printf("Types: %s\n", typeBadges(cfg, gameTypes(cfg, species)))
+	printAbilities(cfg, species)
+	printSpeciesEntry(cfg, species)
+
+	base := game.BaseStats(species)
+	stats := p.Stats(species)
+	rows := [][]string{}
+	for _, stat := range game.StatNames {
+		value := strconv.Itoa(stats[stat])
+		switch p.Nature.Modifier(stat) {
+		case 110:
+			value = ui.Colorize(render.Red, value+"+")
+		case 90:
+			value = ui.Colorize(render.Cyan, value+"-")
+		}
+		rows = append(rows, []string{
+			stat,
+			strconv.Itoa(base[stat]),
+			strconv.Itoa(p.IVs[stat]),
+			strconv.Itoa(p.EVs[stat]),
+			value,
+			ui.StatBar(base[stat], maxBaseStat, statBarWidth),
+		})
+	}
+	ui.Table([]string{cfg.msg.Sprintf("Stat"), cfg.msg.Sprintf("Base"), "IV", "EV", cfg.msg.Sprintf("Lv %d", p.Level), ""}, rows)
+	cfg.msg.Printf("%s %d base, %d at level %d\n", ui.Bold(cfg.msg.Sprintf("Total:")), base.Total(), stats.Total(), p.Level)
+}
+
+// commandLookup displays the details of any Pokemon, caught or not.
+func commandLookup(cfg *config, flags flagSet, args ...string) error {
 	if len(args) != 1 {
-		return errors.New("you must provide a pokemon name")
+		return messages.Errorf("you must provide a pokemon name")
+	}
+	return lookupPokemon(cfg, toSlug(args[0]))
+}
+
+// lookupPokemon fetches a Pokemon and shows the details of its species.
+func lookupPokemon(cfg *config, name string) error {
+	pokemon, err := cfg.pokeapiClient.GetPokemon(name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointPokemon, name, err)
+	}
+	record := newPokemonRecord(pokemon, cfg.game.generation)
+	return printRecords(cfg, record, func() {
+		printPokemonDetails(cfg, pokemon)
+	})
+}
+
+/*
+printPokemonDetails prints a Pokemon's picture, size, types, abilities and base stats,
+drawing a bar for each stat and the total base stat.
+*/
+func printPokemonDetails(cfg *config, pokemon pokeapi.Pokemon) {
+	ui := cfg.ui
+	printSprite(cfg, pokemon)
+	fmt.Printf("%s #%d\n", ui.Bold(cfg.msg.Sprintf("Name: %s", pokemonName(cfg, pokemon.Name))), pokemon.ID)
+	cfg.msg.Printf("Height: %d\nWeight: %d\n", pokemon.Height, pokemon.Weight)
+	cfg.msg.Printf("Types: %s\n", typeBadges(cfg, gameTypes(cfg, pokemon)))
+	printAbilities(cfg, pokemon)
+	printSpeciesEntry(cfg, pokemon)
+
+	rows := [][]string{}
+	total := 0
+	for _, stat := range pokemon.Stats {
+		rows = append(rows, []string{stat.Stat.Name, strconv.Itoa(stat.BaseStat), ui.StatBar(stat.BaseStat, maxBaseStat, statBarWidth)})
+		total += stat.BaseStat
+	}
+	ui.Table([]string{cfg.msg.Sprintf("Stat"), cfg.msg.Sprintf("Base"), ""}, rows)
+	fmt.Printf("%s %d\n", ui.Bold(cfg.msg.Sprintf("Total:")), total)
+}
+
+/*
+printSprite draws the Pokemon's front sprite when the terminal can show it.
+The picture is optional, so download and decoding errors are ignored.
+*/
+func printSprite(cfg *config, pokemon pokeapi.Pokemon) {
+	if !cfg.ui.Color || pokemon.Sprites.FrontDefault == nil {
+		return
+	}
+	sprite, err := cfg.pokeapiClient.GetSprite(*pokemon.Sprites.FrontDefault)
+	if err != nil {
+		return
+	}
+	_ = cfg.ui.Sprite(sprite)
+}
+
+/*
+commandSet changes a REPL setting, or lists the current settings when called
+without arguments.
+*/
+func commandSet(cfg *config, flags flagSet, args ...string) error {
+	if len(args) == 0 {
+		cfg.msg.Printf("output: %s\n", cfg.output)
+		cfg.msg.Printf("mode: %s\n", cfg.mode)
+		cfg.msg.Printf("version: %s\n", cfg.game)
+		cfg.msg.Printf("language: %s\n", cfg.language)
+		return nil
 	}
-	name := args[0]
-	if pokemon, ok := cfg.caughtPokemon[name]; ok {
-		fmt.Printf("Name: %s\nHeight: %d\nWeight: %d\n", pokemon.Name, pokemon.Height, pokemon.Weight)
-		fmt.Println("Stats:")
-		for _, stat := range pokemon.Stats {
-			fmt.Printf(" - %s: %d\n", stat.Stat.Name, stat.BaseStat)
+	if len(args) != 2 {
+		return messages.Errorf("usage: set <setting> <value>")
+	}
+
+	switch toSlug(args[0]) {
+	case "output":
+		format, err := output.ParseFormat(args[1])
+		if err != nil {
+			return err
 		}
-		fmt.Println("Types:")
-		for _, typeInfo := range pokemon.Types {
-			fmt.Printf(" - %s\n", typeInfo.Type.Name)
+		cfg.output = format
+		return nil
+	case "mode":
+		mode, err := parseMode(args[1])
+		if err != nil {
+			return err
 		}
+		cfg.mode = mode
 		return nil
+	case "version":
+		return setGameVersion(cfg, args[1])
+	case "language":
+		return setLanguage(cfg, args[1])
+	default:
+		return messages.Errorf("unknown setting %q", args[0])
+	}
+}
+
+/*
+commandSearch looks up Pokemon and location-area names similar to the given
+term, so the exact API name doesn't have to be known.
+*/
+func commandSearch(cfg *config, flags flagSet, args ...string) error {
+	if len(args) == 0 {
+		return messages.Errorf("you must provide a search term")
+	}
+	limit, err := flags.getInt("limit", 10)
+	if err != nil {
+		return err
+	}
+
+	endpoints := []string{pokeapi.EndpointPokemon, pokeapi.EndpointLocationArea}
+	switch flags.get("kind") {
+	case "":
+	case "pokemon":
+		endpoints = []string{pokeapi.EndpointPokemon}
+	case "location", "location-area":
+		endpoints = []string{pokeapi.EndpointLocationArea}
+	default:
+		return messages.Errorf("unknown kind %q (choose from pokemon, location)", flags.get("kind"))
 	}
 
-	return fmt.Errorf("can't show information on %s. you need to catch one first", name)
+	term := strings.Join(args, " ")
+	records := []searchRecord{}
+	for _, endpoint := range endpoints {
+		idx, err := nameIndex(cfg, endpoint)
+		if err != nil {
+			return err
+		}
+		for _, match := range idx.Search(term, suggestionScore, limit) {
+			records = append(records, searchRecord{Kind: endpoint, Name: match.Name, Score: match.Score})
+		}
+	}
+	slices.SortStableFunc(records, func(a, b searchRecord) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	if len(records) > limit {
+		records = records[:limit]
+	}
+	if len(records) == 0 {
+		return messages.Errorf("nothing matches %q", term)
+	}
+
+	return printRecords(cfg, records, func() {
+		rows := [][]string{}
+		for _, record := range records {
+			rows = append(rows, []string{record.Name, record.Kind, fmt.Sprintf("%.0f%%", record.Score*100)})
+		}
+		cfg.ui.Table([]string{cfg.msg.Sprintf("Name"), cfg.msg.Sprintf("Kind"), cfg.msg.Sprintf("Match")}, rows)
+	})
 }
 
 /*
-commandPokedex displays a list of all caught Pokemon.
-If no Pokemon have been caught, it returns an error.
+commandRegions lists the regions of the Pokemon world.
 */
-func commandPokedex(cfg *config, args ...string) error {
-	if len(cfg.caughtPokemon) > 0 {
-		fmt.Println("Your Pokedex:")
-		for name := range cfg.caughtPokemon {
-			fmt.Printf(" - %s\n", name)
+func commandRegions(cfg *config, flags flagSet, args ...string) error {
+	records := []pokeapi.Resource{}
+	for region, err := range cfg.pokeapiClient.All(pokeapi.EndpointRegion, pokeapi.IterOptions{}) {
+		if err != nil {
+			return err
 		}
-		return nil
+		records = append(records, region)
+	}
+
+	return printRecords(cfg, records, func() {
+		for _, region := range records {
+			fmt.Println(region.Name)
+		}
+	})
+}
+
+/*
+commandRegion displays a summary of a region: its main generation, games,
+regional pokedexes and number of locations.
+*/
+func commandRegion(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 1 {
+		return messages.Errorf("you must provide a region name")
+	}
+
+	name := toSlug(args[0])
+	region, err := cfg.pokeapiClient.GetRegion(name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointRegion, name, err)
 	}
 
-	return errors.New("your pokedex is empty. go catch some pokemon")
+	record := regionRecord{
+		Name:          region.Name,
+		Generation:    region.MainGeneration.Name,
+		VersionGroups: resourceNames(region.VersionGroups),
+		Pokedexes:     resourceNames(region.Pokedexes),
+		Locations:     len(region.Locations),
+	}
+	return printRecords(cfg, record, func() {
+		fmt.Println(cfg.ui.Bold(cfg.msg.Sprintf("Region: %s", record.Name)))
+		cfg.msg.Printf("Generation: %s\n", record.Generation)
+		cfg.msg.Printf("Games: %s\n", strings.Join(record.VersionGroups, ", "))
+		cfg.msg.Printf("Pokedexes: %s\n", strings.Join(record.Pokedexes, ", "))
+		cfg.msg.Printf("Locations: %d (list them with: locations %s)\n", record.Locations, record.Name)
+	})
+}
+
+/*
+commandLocations lists the locations in a region. Each location can be
+explored to see the Pokemon in all of its areas.
+*/
+func commandLocations(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 1 {
+		return messages.Errorf("you must provide a region name")
+	}
+
+	name := toSlug(args[0])
+	region, err := cfg.pokeapiClient.GetRegion(name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointRegion, name, err)
+	}
+
+	records := []locationRecord{}
+	for _, loc := range region.Locations {
+		records = append(records, locationRecord{Name: loc.Name, URL: loc.URL})
+	}
+	slices.SortFunc(records, func(a, b locationRecord) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return printRecords(cfg, records, func() {
+		names := []string{}
+		for _, loc := range records {
+			names = append(names, loc.Name)
+		}
+		prefetchNames(cfg, pokeapi.EndpointLocation, names)
+		for _, loc := range records {
+			fmt.Println(labeledName(cfg, pokeapi.EndpointLocation, loc.Name))
+		}
+		fmt.Println(cfg.ui.Colorize(render.Gray, cfg.msg.Sprintf("%d locations in %s", len(records), region.Name)))
+	})
+}
+
+// resourceNames returns the names of the given resources.
+func resourceNames(resources []pokeapi.Resource) []string {
+	names := []string{}
+	for _, resource := range resources {
+		names = append(names, resource.Name)
+	}
+	return names
 }
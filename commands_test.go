@@ -0,0 +1,361 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/output"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+func TestParseMode(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected string
+		wantErr  bool
+	}{
+		{name: "game", expected: gameMode},
+		{name: "Reference", expected: referenceMode},
+		{name: " reference ", expected: referenceMode},
+		{name: "battle", wantErr: true},
+		{name: "", wantErr: true},
+	}
+	for _, c := range cases {
+		mode, err := parseMode(c.name)
+		if (err != nil) != c.wantErr || mode != c.expected {
+			t.Errorf("parseMode(%q): expected %q (error %v), got %q (%v)", c.name, c.expected, c.wantErr, mode, err)
+		}
+	}
+}
+
+func TestAnyPokemon(t *testing.T) {
+	cases := []struct {
+		mode     string
+		flags    flagSet
+		expected bool
+	}{
+		{mode: gameMode, flags: flagSet{}, expected: false},
+		{mode: gameMode, flags: flagSet{"any": ""}, expected: true},
+		{mode: referenceMode, flags: flagSet{}, expected: true},
+		{mode: referenceMode, flags: flagSet{"any": ""}, expected: true},
+	}
+	for _, c := range cases {
+		if got := anyPokemon(&config{mode: c.mode}, c.flags); got != c.expected {
+			t.Errorf("%s mode with %v: expected %v, got %v", c.mode, c.flags, c.expected, got)
+		}
+	}
+}
+
+func TestInspectUnknownID(t *testing.T) {
+	for _, mode := range []string{gameMode, referenceMode} {
+		cfg := &config{
+			caughtPokemon: map[string]pokeapi.Pokemon{},
+			storage:       game.NewStorage(),
+			mode:          mode,
+		}
+		err := commandInspect(cfg, flagSet{"any": ""}, "25")
+		if err == nil || err.Error() != "you don't have a Pokemon with ID 25" {
+			t.Errorf("%s mode: expected ID 25 not to be found, got %v", mode, err)
+		}
+	}
+}
+
+func TestPageCount(t *testing.T) {
+	cases := []struct {
+		count, limit, expected int
+	}{
+		{count: 0, limit: 20, expected: 1},
+		{count: 20, limit: 20, expected: 1},
+		{count: 21, limit: 20, expected: 2},
+		{count: 1089, limit: 20, expected: 55},
+		{count: 45, limit: 7, expected: 7},
+	}
+	for _, c := range cases {
+		if got := pageCount(c.count, c.limit); got != c.expected {
+			t.Errorf("pageCount(%d, %d): expected %d, got %d", c.count, c.limit, c.expected, got)
+		}
+	}
+}
+
+// locationPages returns fixtures for the pages of count location areas,
+// named area-1 to area-<count>, and for empty pages past them, at the given page sizes.
+func locationPages(t *testing.T, count int, limits ...int) map[string]string {
+	t.Helper()
+	pages := map[string]string{}
+	for _, limit := range limits {
+		for offset := 0; offset < 2*count; offset += limit {
+			page := pokeapi.LocationAreaList{Count: count}
+			for i := offset; i < min(offset+limit, count); i++ {
+				page.Results = append(page.Results, pokeapi.Resource{Name: fmt.Sprintf("area-%d", i+1)})
+			}
+			body, err := json.Marshal(page)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pages[fmt.Sprintf("location-area?offset=%d&limit=%d", offset, limit)] = string(body)
+		}
+	}
+	return pages
+}
+
+func TestMapPages(t *testing.T) {
+	cfg := newFakeConfig(&fakeAPI{responses: locationPages(t, 45, 20, 7)})
+	steps := []struct {
+		command string
+		args    []string
+		flags   flagSet
+		first   string // the first area on the page
+		footer  string
+		err     string
+	}{
+		{command: "map", first: "area-1", footer: "page 1 of 3 (45 areas)"},
+		{command: "map", first: "area-21", footer: "page 2 of 3 (45 areas)"},
+		// At 7 per page, area-21 is on page 3, so the next page is 4.
+		{command: "map", flags: flagSet{"limit": "7"}, first: "area-22", footer: "page 4 of 7 (45 areas)"},
+		{command: "mapb", first: "area-15", footer: "page 3 of 7 (45 areas)"},
+		{command: "map", args: []string{"last"}, first: "area-43", footer: "page 7 of 7 (45 areas)"},
+		{command: "map", err: "you're on the last page"},
+		{command: "map", args: []string{"2"}, first: "area-8", footer: "page 2 of 7 (45 areas)"},
+		{command: "mapb", first: "area-1", footer: "page 1 of 7 (45 areas)"},
+		{command: "mapb", err: "you're on the first page"},
+		{command: "map", args: []string{"9"}, err: "page 9 doesn't exist, there are 7 pages"},
+		{command: "map", args: []string{"next"}, err: `invalid page "next": use a page number, first or last`},
+		{command: "map", args: []string{"first"}, flags: flagSet{"limit": "20"}, first: "area-1", footer: "page 1 of 3 (45 areas)"},
+		{command: "map", flags: flagSet{"limit": "0"}, err: "the limit must be at least 1"},
+	}
+	for i, step := range steps {
+		var err error
+		printed := captureStdout(t, cfg, func() {
+			err = getCommands()[step.command].callback(cfg, step.flags, step.args...)
+		})
+		if step.err != "" {
+			if err == nil || err.Error() != step.err {
+				t.Errorf("step %d: expected %q, got %v", i, step.err, err)
+			}
+			continue
+		}
+		lines := strings.Split(strings.TrimSpace(printed), "\n")
+		if err != nil || lines[0] != step.first || lines[len(lines)-1] != step.footer {
+			t.Errorf("step %d: expected %s ... %s, got %v:\n%s", i, step.first, step.footer, err, printed)
+		}
+	}
+}
+
+// sinnohFixtures is a small slice of the Sinnoh region: a location with two
+// areas and a location without any.
+var sinnohFixtures = map[string]string{
+	"region?offset=0&limit=20": `{"count": 2, "results": [{"name": "kanto"}, {"name": "sinnoh"}]}`,
+	"region/sinnoh": `{
+		"name": "sinnoh",
+		"main_generation": {"name": "generation-iv"},
+		"locations": [{"name": "sunyshore-city"}, {"name": "lake-verity"}],
+		"pokedexes": [{"name": "original-sinnoh"}],
+		"version_groups": [{"name": "diamond-pearl"}, {"name": "platinum"}]
+	}`,
+	"location/sunyshore-city": `{"name": "sunyshore-city", "areas": [{"name": "sunyshore-city-area"}, {"name": "sunyshore-city-beach"}]}`,
+	"location/lake-verity":    `{"name": "lake-verity", "areas": []}`,
+	"location-area/sunyshore-city-area": `{
+		"name": "sunyshore-city-area",
+		"location": {"name": "sunyshore-city"},
+		"pokemon_encounters": [{
+			"pokemon": {"name": "pikachu", "url": "https://pokeapi.co/api/v2/pokemon-species/25/"},
+			"version_details": [{"version": {"name": "diamond"}, "encounter_details": [
+				{"min_level": 3, "max_level": 5, "chance": 100, "method": {"name": "walk"}}
+			]}]
+		}]
+	}`,
+	"location-area/sunyshore-city-beach": `{
+		"name": "sunyshore-city-beach",
+		"location": {"name": "sunyshore-city"},
+		"pokemon_encounters": [{
+			"pokemon": {"name": "staryu", "url": "https://pokeapi.co/api/v2/pokemon-species/120/"},
+			"version_details": [{"version": {"name": "pearl"}, "encounter_details": [
+				{"min_level": 20, "max_level": 30, "chance": 100, "method": {"name": "surf"}}
+			]}]
+		}]
+	}`,
+}
+
+func TestRegionCommands(t *testing.T) {
+	cases := []struct {
+		command  string
+		args     []string
+		expected string
+		err      string
+	}{
+		{command: "regions", expected: "kanto\nsinnoh\n"},
+		{
+			command: "region",
+			args:    []string{"Sinnoh"},
+			expected: "Region: sinnoh\nGeneration: generation-iv\nGames: diamond-pearl, platinum\n" +
+				"Pokedexes: original-sinnoh\nLocations: 2 (list them with: locations sinnoh)\n",
+		},
+		{command: "locations", args: []string{"sinnoh"}, expected: "lake-verity\nsunyshore-city\n2 locations in sinnoh\n"},
+		{command: "region", args: []string{"johto"}, err: `region "johto" not found`},
+		{command: "locations", err: "you must provide a region name"},
+	}
+	cfg := newFakeConfig(&fakeAPI{responses: sinnohFixtures})
+	for _, c := range cases {
+		var err error
+		printed := captureStdout(t, cfg, func() {
+			err = getCommands()[c.command].callback(cfg, nil, c.args...)
+		})
+		if c.err != "" {
+			if err == nil || err.Error() != c.err {
+				t.Errorf("%s %v: expected %q, got %v", c.command, c.args, c.err, err)
+			}
+			continue
+		}
+		if err != nil || printed != c.expected {
+			t.Errorf("%s %v: expected:\n%s\ngot %v:\n%s", c.command, c.args, c.expected, err, printed)
+		}
+	}
+}
+
+func TestExploreLocation(t *testing.T) {
+	cfg := newFakeConfig(&fakeAPI{responses: sinnohFixtures})
+	printed := captureStdout(t, cfg, func() {
+		if err := commandExplore(cfg, nil, "Sunyshore City"); err != nil {
+			t.Fatal(err)
+		}
+	})
+	for _, expected := range []string{"Exploring sunyshore-city-area...", "pikachu", "Exploring sunyshore-city-beach...", "staryu"} {
+		if !strings.Contains(printed, expected) {
+			t.Errorf("expected %q in:\n%s", expected, printed)
+		}
+	}
+	if len(cfg.areasExplored) != 2 || cfg.locationExplored != "sunyshore-city" {
+		t.Errorf("expected both areas of sunyshore-city to be explored, got %q with %d areas", cfg.locationExplored, len(cfg.areasExplored))
+	}
+	if !cfg.pokedex.HasSeen(25) || !cfg.pokedex.HasSeen(120) {
+		t.Errorf("expected pikachu and staryu to be seen")
+	}
+
+	// An area is explored by itself.
+	captureStdout(t, cfg, func() {
+		if err := commandExplore(cfg, nil, "sunyshore-city-beach"); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if len(cfg.areasExplored) != 1 || cfg.areasExplored[0].Name != "sunyshore-city-beach" {
+		t.Errorf("expected only sunyshore-city-beach to be explored, got %d areas", len(cfg.areasExplored))
+	}
+
+	if err := commandExplore(cfg, nil, "lake-verity"); err == nil || err.Error() != "lake-verity has no areas to explore" {
+		t.Errorf("expected lake-verity to have no areas, got %v", err)
+	}
+	if err := commandExplore(cfg, nil, "mt-coronet"); err == nil || err.Error() != `location area "mt-coronet" not found` {
+		t.Errorf("expected mt-coronet not to be found, got %v", err)
+	}
+}
+
+func TestExploreDetails(t *testing.T) {
+	api := &fakeAPI{responses: map[string]string{"location-area/test-area": testAreaJSON}}
+	cfg := newFakeConfig(api)
+	printed := captureStdout(t, cfg, func() {
+		if err := commandExplore(cfg, flagSet{"details": "", "version": "Diamond"}, "test-area"); err != nil {
+			t.Fatal(err)
+		}
+	})
+	for _, row := range []string{
+		"pikachu    diamond  walk    3-7     40%",
+		"bulbasaur  diamond  walk    2-4     60%",
+		"walk    diamond  10%",
+	} {
+		if !strings.Contains(printed, row) {
+			t.Errorf("expected the row %q in:\n%s", row, printed)
+		}
+	}
+	if strings.Contains(printed, "staryu") || strings.Contains(printed, "pearl") {
+		t.Errorf("expected only diamond encounters, got:\n%s", printed)
+	}
+
+	cfg.output = output.JSON
+	printed = captureStdout(t, cfg, func() {
+		if err := commandExplore(cfg, flagSet{"details": "", "method": "surf"}, "test-area"); err != nil {
+			t.Fatal(err)
+		}
+	})
+	records := []encounterDetailRecord{}
+	if err := json.Unmarshal([]byte(printed), &records); err != nil {
+		t.Fatalf("%v in %s", err, printed)
+	}
+	expected := []encounterDetailRecord{
+		{Location: "test-area", Pokemon: "staryu", Version: "pearl", Method: "surf", MinLevel: 20, MaxLevel: 30, Chance: 100},
+	}
+	if !slices.Equal(records, expected) {
+		t.Errorf("expected %+v, got %+v", expected, records)
+	}
+}
+
+// catchFixtures has everything needed to catch a wild Pikachu with a Master Ball.
+func catchFixtures() map[string]string {
+	return map[string]string{
+		"item/master-ball":          `{"name": "master-ball", "category": {"name": "standard-balls"}}`,
+		"pokemon/pikachu":           `{"id": 25, "name": "pikachu", "base_experience": 112, "species": {"name": "pikachu"}}`,
+		"nature?offset=0&limit=100": `{"count": 1, "results": [{"name": "hardy"}]}`,
+		"nature/hardy":              `{"name": "hardy"}`,
+		"pokemon-species/pikachu":   `{"name": "pikachu", "growth_rate": {"name": "medium-fast"}}`,
+		"growth-rate/medium-fast":   `{"name": "medium-fast", "levels": [{"level": 5, "experience": 125}]}`,
+	}
+}
+
+func TestCatchKeepsStateWhenRequestsFail(t *testing.T) {
+	for _, failing := range []string{"nature?offset=0&limit=100", "nature/hardy", "pokemon-species/pikachu", "growth-rate/medium-fast"} {
+		fixtures := catchFixtures()
+		fixtures[failing] = failRequest
+		cfg := newFakeConfig(&fakeAPI{responses: fixtures})
+		cfg.rng = rand.New(rand.NewSource(1))
+		cfg.bag["master-ball"] = 1
+		bag := maps.Clone(cfg.bag)
+		wild := &wildPokemon{name: "pikachu", number: 25, level: 5, area: "viridian-forest-area", method: "walk"}
+		cfg.wildPokemon = wild
+
+		captureStdout(t, cfg, func() {
+			if err := commandCatch(cfg, flagSet{"ball": "master-ball"}); err == nil {
+				t.Errorf("%s: expected the catch to fail", failing)
+			}
+		})
+		if !maps.Equal(cfg.bag, bag) {
+			t.Errorf("%s: expected the bag to be unchanged, got %v", failing, cfg.bag)
+		}
+		if cfg.wildPokemon != wild {
+			t.Errorf("%s: expected the encounter to go on", failing)
+		}
+		if len(cfg.storage.All()) != 0 || len(cfg.caughtPokemon) != 0 || cfg.pokedex.HasSeen(25) {
+			t.Errorf("%s: expected nothing to be caught", failing)
+		}
+	}
+}
+
+func TestCatch(t *testing.T) {
+	cfg := newFakeConfig(&fakeAPI{responses: catchFixtures()})
+	cfg.rng = rand.New(rand.NewSource(1))
+	cfg.bag["master-ball"] = 1
+	cfg.wildPokemon = &wildPokemon{name: "pikachu", number: 25, level: 5, area: "viridian-forest-area", method: "walk"}
+
+	printed := captureStdout(t, cfg, func() {
+		if err := commandCatch(cfg, flagSet{"ball": "master-ball"}, "Pikachu"); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(printed, "pikachu (ID 1) joined your party.") {
+		t.Errorf("expected pikachu to join the party, got:\n%s", printed)
+	}
+	if cfg.bag["master-ball"] != 0 || cfg.wildPokemon != nil {
+		t.Errorf("expected the ball to be used and the encounter to end")
+	}
+	party := cfg.storage.Party
+	if len(party) != 1 || party[0].Species != "pikachu" || party[0].Level != 5 || party[0].XP != 125 {
+		t.Errorf("expected a level 5 pikachu with 125 XP in the party, got %+v", party)
+	}
+	if _, ok := cfg.caughtPokemon["pikachu"]; !ok || !cfg.pokedex.HasSeen(25) {
+		t.Errorf("expected pikachu to be recorded as caught")
+	}
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+	"github.com/OferRavid/pokedexcli/internal/render"
+)
+
+/*
+commandCompare shows two or more Pokemon side by side: their types,
+abilities, size, base stats and how much damage they take from each type.
+The highest value of every stat is highlighted, and types are those of the
+chosen game's generation. Only caught Pokemon can be compared, unless --any
+is given or in reference mode.
+*/
+func commandCompare(cfg *config, flags flagSet, args ...string) error {
+	if len(args) < 2 {
+		return messages.Errorf("usage: compare <pokemon> <pokemon> [pokemon...] [--any]")
+	}
+
+	types := map[string]pokeapi.Type{}
+	pokemon := []pokeapi.Pokemon{}
+	records := []compareRecord{}
+	for _, arg := range args {
+		p, err := comparedPokemon(cfg, toSlug(arg), anyPokemon(cfg, flags))
+		if err != nil {
+			return err
+		}
+		defending := []pokeapi.Type{}
+		for _, name := range gameTypes(cfg, p) {
+			if _, ok := types[name]; !ok {
+				t, err := cfg.pokeapiClient.GetType(name)
+				if err != nil {
+					return err
+				}
+				types[name] = t
+			}
+			defending = append(defending, types[name])
+		}
+		pokemon = append(pokemon, p)
+		records = append(records, compareRecord{
+			pokemonRecord: newPokemonRecord(p, cfg.game.generation),
+			Total:         game.BaseStats(p).Total(),
+			Matchups:      game.Matchups(defending, cfg.game.generation),
+		})
+	}
+
+	return printRecords(cfg, records, func() {
+		printComparison(cfg, pokemon, records)
+	})
+}
+
+// comparedPokemon returns the data of a caught Pokemon, or of any Pokemon when
+// anyPokemon is set.
+func comparedPokemon(cfg *config, name string, anyPokemon bool) (pokeapi.Pokemon, error) {
+	if p, ok := cfg.caughtPokemon[name]; ok {
+		return p, nil
+	}
+	if !anyPokemon {
+		return pokeapi.Pokemon{}, messages.Errorf("you haven't caught %s. compare any Pokemon with --any", name)
+	}
+	p, err := cfg.pokeapiClient.GetPokemon(name)
+	if err != nil {
+		return pokeapi.Pokemon{}, notFoundError(cfg, pokeapi.EndpointPokemon, name, err)
+	}
+	return p, nil
+}
+
+// printComparison prints a table with a column for each compared Pokemon.
+func printComparison(cfg *config, pokemon []pokeapi.Pokemon, records []compareRecord) {
+	ui := cfg.ui
+	typeName := func(name string) string { return displayName(cfg, pokeapi.EndpointType, name) }
+	headers := []string{""}
+	for _, r := range records {
+		headers = append(headers, ui.Bold(pokemonName(cfg, r.Name)))
+	}
+	row := func(label string, cell func(r compareRecord) string) []string {
+		cells := []string{label}
+		for _, r := range records {
+			cells = append(cells, cell(r))
+		}
+		return cells
+	}
+
+	rows := [][]string{
+		row(cfg.msg.Sprintf("No."), func(r compareRecord) string { return fmt.Sprintf("#%d", r.ID) }),
+		row(cfg.msg.Sprintf("Types"), func(r compareRecord) string { return typeBadges(cfg, r.Types) }),
+		row(cfg.msg.Sprintf("Abilities"), func(r compareRecord) string {
+			names := displayNames(cfg, pokeapi.EndpointAbility, r.Abilities)
+			for _, name := range displayNames(cfg, pokeapi.EndpointAbility, r.HiddenAbilities) {
+				names = append(names, ui.Colorize(render.Gray, cfg.msg.Sprintf("%s (hidden)", name)))
+			}
+			return strings.Join(names, ", ")
+		}),
+		row(cfg.msg.Sprintf("Height"), func(r compareRecord) string { return strconv.Itoa(r.Height) }),
+		row(cfg.msg.Sprintf("Weight"), func(r compareRecord) string { return strconv.Itoa(r.Weight) }),
+	}
+
+	base := []game.Stats{}
+	for _, p := range pokemon {
+		stats := game.BaseStats(p)
+		stats["total"] = stats.Total()
+		base = append(base, stats)
+	}
+	for _, stat := range append(slices.Clone(game.StatNames), "total") {
+		best := 0
+		for _, stats := range base {
+			best = max(best, stats[stat])
+		}
+		cells := []string{stat}
+		for _, stats := range base {
+			cell := strconv.Itoa(stats[stat])
+			if stats[stat] == best {
+				cell = ui.Colorize(render.Green, ui.Bold(cell))
+			}
+			cells = append(cells, cell)
+		}
+		rows = append(rows, cells)
+	}
+
+	rows = append(rows,
+		row(cfg.msg.Sprintf("Weak to"), func(r compareRecord) string {
+			return formatMatchups(r.Matchups, func(m float64) bool { return m > 1 }, typeName)
+		}),
+		row(cfg.msg.Sprintf("Resists"), func(r compareRecord) string {
+			return formatMatchups(r.Matchups, func(m float64) bool { return m > 0 && m < 1 }, typeName)
+		}),
+		row(cfg.msg.Sprintf("Immune to"), func(r compareRecord) string {
+			return formatMatchups(r.Matchups, func(m float64) bool { return m == 0 }, typeName)
+		}),
+	)
+	ui.Table(headers, rows)
+}
+
+// formatMatchups lists the attacking types whose multiplier passes keep, the
+// most effective first, e.g. "grass x4, electric x2". Types are shown by typeName.
+func formatMatchups(matchups map[string]float64, keep func(float64) bool, typeName func(string) string) string {
+	types := []string{}
+	for name, m := range matchups {
+		if keep(m) {
+			types = append(types, name)
+		}
+	}
+	slices.SortFunc(types, func(a, b string) int {
+		return cmp.Or(cmp.Compare(matchups[b], matchups[a]), cmp.Compare(a, b))
+	})
+
+	parts := []string{}
+	for _, name := range types {
+		if matchups[name] == 0 {
+			parts = append(parts, typeName(name))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s x%s", typeName(name), strconv.FormatFloat(matchups[name], 'g', -1, 64)))
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatMatchups(t *testing.T) {
+	matchups := map[string]float64{"grass": 4, "electric": 0, "fire": 0.5, "steel": 0.5, "rock": 0.25, "ice": 2}
+	cases := []struct {
+		keep     func(float64) bool
+		expected string
+	}{
+		{keep: func(m float64) bool { return m > 1 }, expected: "GRASS x4, ICE x2"},
+		{keep: func(m float64) bool { return m > 0 && m < 1 }, expected: "FIRE x0.5, STEEL x0.5, ROCK x0.25"},
+		{keep: func(m float64) bool { return m == 0 }, expected: "ELECTRIC"},
+		{keep: func(m float64) bool { return m > 4 }, expected: "-"},
+	}
+	for _, c := range cases {
+		if got := formatMatchups(matchups, c.keep, strings.ToUpper); got != c.expected {
+			t.Errorf("expected %q, got %q", c.expected, got)
+		}
+	}
+}
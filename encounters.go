@@ -0,0 +1,247 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// encounterSlot summarizes how a Pokemon can be encountered in a location area
+// with one method in one game version.
+type encounterSlot struct {
+	area     string
+	pokemon  string
+	url      string // the Pokemon's PokeAPI URL
+	version  string
+	method   string
+	minLevel int
+	maxLevel int
+	chance   int // percent chance of meeting this Pokemon with the method
+}
+
+/*
+encounterSlots lists the ways each Pokemon can be encountered in an area.
+The encounter details of a Pokemon are merged per version and method, see
+mergeEncounters. No versions or an empty method match everything.
+*/
+func encounterSlots(area pokeapi.LocationArea, versions []string, method string) []encounterSlot {
+	slots := []encounterSlot{}
+	index := map[[4]string]int{}
+	for _, enc := range area.PokemonEncounters {
+		slots = mergeEncounters(slots, index, area.Name, enc.Pokemon, enc.VersionDetails, versions, method)
+	}
+	return slots
+}
+
+// pokemonSlots lists the ways a Pokemon can be encountered in each location
+// area where it lives, filtered like encounterSlots.
+func pokemonSlots(pokemon pokeapi.Pokemon, encounters []pokeapi.LocationAreaEncounter, versions []string, method string) []encounterSlot {
+	slots := []encounterSlot{}
+	index := map[[4]string]int{}
+	ref := pokeapi.Resource{Name: pokemon.Name}
+	for _, enc := range encounters {
+		slots = mergeEncounters(slots, index, enc.LocationArea.Name, ref, enc.VersionDetails, versions, method)
+	}
+	return slots
+}
+
+/*
+mergeEncounters adds the encounters of a Pokemon in an area to the slots.
+Encounters with the same version and method are merged into one slot: the
+level range covers all of them and the chances are added up. The index maps
+the area, Pokemon, version and method of each slot to its position.
+No versions or an empty method match everything.
+*/
+func mergeEncounters(slots []encounterSlot, index map[[4]string]int, area string, pokemon pokeapi.Resource, details []pokeapi.VersionEncounterDetail, versions []string, method string) []encounterSlot {
+	for _, versionDetails := range details {
+		if !matchesVersion(versions, versionDetails.Version.Name) {
+			continue
+		}
+		for _, enc := range versionDetails.EncounterDetails {
+			if method != "" && enc.Method.Name != method {
+				continue
+			}
+			key := [4]string{area, pokemon.Name, versionDetails.Version.Name, enc.Method.Name}
+			if i, ok := index[key]; ok {
+				slots[i].minLevel = min(slots[i].minLevel, enc.MinLevel)
+				slots[i].maxLevel = max(slots[i].maxLevel, enc.MaxLevel)
+				slots[i].chance += enc.Chance
+				continue
+			}
+			index[key] = len(slots)
+			slots = append(slots, encounterSlot{
+				area:     area,
+				pokemon:  pokemon.Name,
+				url:      pokemon.URL,
+				version:  versionDetails.Version.Name,
+				method:   enc.Method.Name,
+				minLevel: enc.MinLevel,
+				maxLevel: enc.MaxLevel,
+				chance:   enc.Chance,
+			})
+		}
+	}
+	return slots
+}
+
+// levelRange formats a level range as "5" or "3-7".
+func (s encounterSlot) levelRange() string {
+	if s.minLevel == s.maxLevel {
+		return strconv.Itoa(s.minLevel)
+	}
+	return fmt.Sprintf("%d-%d", s.minLevel, s.maxLevel)
+}
+
+// methodRate is how often a method triggers an encounter in a game version.
+type methodRate struct {
+	method  string
+	version string
+	rate    int
+}
+
+/*
+methodRates lists the encounter rate of each method in an area, filtered the
+same way as encounterSlots.
+*/
+func methodRates(area pokeapi.LocationArea, versions []string, method string) []methodRate {
+	rates := []methodRate{}
+	for _, methodRates := range area.EncounterMethodRates {
+		if method != "" && methodRates.EncounterMethod.Name != method {
+			continue
+		}
+		for _, details := range methodRates.VersionDetails {
+			if !matchesVersion(versions, details.Version.Name) {
+				continue
+			}
+			rates = append(rates, methodRate{
+				method:  methodRates.EncounterMethod.Name,
+				version: details.Version.Name,
+				rate:    details.Rate,
+			})
+		}
+	}
+	return rates
+}
+
+// wildPokemon is a Pokemon met in the wild. It is the only Pokemon that can be caught.
+type wildPokemon struct {
+	name       string
+	number     int // its national dex number
+	level      int
+	area       string
+	method     string
+	individual *game.Pokemon // its stats and HP, nil until it is battled or caught
+}
+
+// encounterCandidate is one entry of an area's encounter table.
+type encounterCandidate struct {
+	area     string
+	pokemon  string
+	url      string
+	version  string
+	minLevel int
+	maxLevel int
+	chance   int
+}
+
+/*
+rollEncounter picks a single wild Pokemon met with the given method.
+
+A game version is picked first (unless one is given) and then one of the areas
+that have encounters for it, so games and areas with bigger encounter tables
+aren't favored. The Pokemon is then drawn using the encounter chances as
+weights, and its level is rolled within the encounter's level range.
+Returns false if no Pokemon can be met with the method.
+*/
+func rollEncounter(areas []pokeapi.LocationArea, method string, versions []string, rng *rand.Rand) (wildPokemon, bool) {
+	candidates := []encounterCandidate{}
+	for _, area := range areas {
+		for _, enc := range area.PokemonEncounters {
+			for _, versionDetails := range enc.VersionDetails {
+				if !matchesVersion(versions, versionDetails.Version.Name) {
+					continue
+				}
+				for _, details := range versionDetails.EncounterDetails {
+					if details.Method.Name != method {
+						continue
+					}
+					candidates = append(candidates, encounterCandidate{
+						area:     area.Name,
+						pokemon:  enc.Pokemon.Name,
+						url:      enc.Pokemon.URL,
+						version:  versionDetails.Version.Name,
+						minLevel: details.MinLevel,
+						maxLevel: details.MaxLevel,
+						chance:   details.Chance,
+					})
+				}
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return wildPokemon{}, false
+	}
+
+	candidates = pickGroup(candidates, func(c encounterCandidate) string { return c.version }, rng)
+	candidates = pickGroup(candidates, func(c encounterCandidate) string { return c.area }, rng)
+
+	total := 0
+	for _, c := range candidates {
+		total += c.chance
+	}
+	picked := candidates[rng.Intn(len(candidates))]
+	if total > 0 {
+		roll := rng.Intn(total)
+		for _, c := range candidates {
+			if roll < c.chance {
+				picked = c
+				break
+			}
+			roll -= c.chance
+		}
+	}
+
+	return wildPokemon{
+		name:   picked.pokemon,
+		number: pokeapi.ResourceID(picked.url),
+		level:  picked.minLevel + rng.Intn(max(0, picked.maxLevel-picked.minLevel)+1),
+		area:   picked.area,
+		method: method,
+	}, true
+}
+
+// pickGroup groups candidates by key, picks one group uniformly and returns its members.
+func pickGroup(candidates []encounterCandidate, key func(encounterCandidate) string, rng *rand.Rand) []encounterCandidate {
+	keys := []string{}
+	for _, c := range candidates {
+		if !slices.Contains(keys, key(c)) {
+			keys = append(keys, key(c))
+		}
+	}
+	picked := keys[rng.Intn(len(keys))]
+
+	group := []encounterCandidate{}
+	for _, c := range candidates {
+		if key(c) == picked {
+			group = append(group, c)
+		}
+	}
+	return group
+}
+
+// encounterMethods lists the encounter methods available in the given areas.
+func encounterMethods(areas []pokeapi.LocationArea) []string {
+	methods := []string{}
+	for _, area := range areas {
+		for _, slot := range encounterSlots(area, nil, "") {
+			if !slices.Contains(methods, slot.method) {
+				methods = append(methods, slot.method)
+			}
+		}
+	}
+	return methods
+}
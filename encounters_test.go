@@ -0,0 +1,204 @@
+package main
+
+import (
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+const testAreaJSON = `{
+	"name": "test-area",
+	"encounter_method_rates": [
+		{
+			"encounter_method": {"name": "walk"},
+			"version_details": [{"version": {"name": "diamond"}, "rate": 10}, {"version": {"name": "pearl"}, "rate": 15}]
+		},
+		{
+			"encounter_method": {"name": "surf"},
+			"version_details": [{"version": {"name": "pearl"}, "rate": 20}]
+		}
+	],
+	"pokemon_encounters": [
+		{
+			"pokemon": {"name": "pikachu"},
+			"version_details": [{
+				"version": {"name": "diamond"},
+				"encounter_details": [
+					{"min_level": 3, "max_level": 5, "chance": 30, "method": {"name": "walk"}},
+					{"min_level": 6, "max_level": 7, "chance": 10, "method": {"name": "walk"}}
+				]
+			}]
+		},
+		{
+			"pokemon": {"name": "bulbasaur"},
+			"version_details": [{
+				"version": {"name": "diamond"},
+				"encounter_details": [
+					{"min_level": 2, "max_level": 4, "chance": 60, "method": {"name": "walk"}}
+				]
+			}]
+		},
+		{
+			"pokemon": {"name": "staryu"},
+			"version_details": [{
+				"version": {"name": "pearl"},
+				"encounter_details": [
+					{"min_level": 20, "max_level": 30, "chance": 100, "method": {"name": "surf"}}
+				]
+			}]
+		}
+	]
+}`
+
+func testArea(t *testing.T) pokeapi.LocationArea {
+	area := pokeapi.LocationArea{}
+	if err := json.Unmarshal([]byte(testAreaJSON), &area); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return area
+}
+
+func TestEncounterSlots(t *testing.T) {
+	slots := encounterSlots(testArea(t), nil, "walk")
+	expected := []encounterSlot{
+		{area: "test-area", pokemon: "pikachu", version: "diamond", method: "walk", minLevel: 3, maxLevel: 7, chance: 40},
+		{area: "test-area", pokemon: "bulbasaur", version: "diamond", method: "walk", minLevel: 2, maxLevel: 4, chance: 60},
+	}
+	if len(slots) != len(expected) {
+		t.Fatalf("Expecting: %+v\nActual:    %+v", expected, slots)
+	}
+	for i := range slots {
+		if slots[i] != expected[i] {
+			t.Errorf("Expecting: %+v\nActual:    %+v", expected[i], slots[i])
+		}
+	}
+}
+
+func TestMethodRates(t *testing.T) {
+	cases := []struct {
+		versions []string
+		method   string
+		expected []methodRate
+	}{
+		{
+			expected: []methodRate{
+				{method: "walk", version: "diamond", rate: 10},
+				{method: "walk", version: "pearl", rate: 15},
+				{method: "surf", version: "pearl", rate: 20},
+			},
+		},
+		{versions: []string{"pearl"}, method: "walk", expected: []methodRate{{method: "walk", version: "pearl", rate: 15}}},
+		{versions: []string{"diamond"}, method: "surf", expected: []methodRate{}},
+	}
+	for _, c := range cases {
+		if rates := methodRates(testArea(t), c.versions, c.method); !slices.Equal(rates, c.expected) {
+			t.Errorf("%v %q: expected %+v, got %+v", c.versions, c.method, c.expected, rates)
+		}
+	}
+}
+
+func TestEncounterSlotFilters(t *testing.T) {
+	if slots := encounterSlots(testArea(t), []string{"pearl"}, ""); len(slots) != 1 || slots[0].pokemon != "staryu" {
+		t.Errorf("expected only staryu in pearl, got %+v", slots)
+	}
+	if slots := encounterSlots(testArea(t), []string{"diamond"}, "surf"); len(slots) != 0 {
+		t.Errorf("expected no surf encounters in diamond, got %+v", slots)
+	}
+	if got := (encounterSlot{minLevel: 3, maxLevel: 7}).levelRange(); got != "3-7" {
+		t.Errorf("expected 3-7, got %q", got)
+	}
+	if got := (encounterSlot{minLevel: 5, maxLevel: 5}).levelRange(); got != "5" {
+		t.Errorf("expected 5, got %q", got)
+	}
+}
+
+func TestRollEncounter(t *testing.T) {
+	areas := []pokeapi.LocationArea{testArea(t)}
+	rng := rand.New(rand.NewSource(1))
+
+	counts := map[string]int{}
+	for range 1000 {
+		wild, ok := rollEncounter(areas, "walk", nil, rng)
+		if !ok {
+			t.Fatalf("expected an encounter")
+		}
+		switch wild.name {
+		case "pikachu":
+			if wild.level < 3 || wild.level > 7 {
+				t.Errorf("pikachu rolled level %d, expected 3-7", wild.level)
+			}
+		case "bulbasaur":
+			if wild.level < 2 || wild.level > 4 {
+				t.Errorf("bulbasaur rolled level %d, expected 2-4", wild.level)
+			}
+		default:
+			t.Fatalf("%s can't be encountered by walking", wild.name)
+		}
+		counts[wild.name]++
+	}
+	if counts["bulbasaur"] < counts["pikachu"] {
+		t.Errorf("expected bulbasaur (60%%) to appear more than pikachu (40%%), got %v", counts)
+	}
+
+	wild, ok := rollEncounter(areas, "surf", nil, rng)
+	if !ok || wild.name != "staryu" {
+		t.Errorf("expected to encounter staryu by surfing, got %+v", wild)
+	}
+	if _, ok := rollEncounter(areas, "old-rod", nil, rng); ok {
+		t.Errorf("expected no encounters with an old rod")
+	}
+	if _, ok := rollEncounter(areas, "surf", []string{"diamond"}, rng); ok {
+		t.Errorf("expected no surf encounters in diamond")
+	}
+}
+
+func TestPokemonSlots(t *testing.T) {
+	encounters := []pokeapi.LocationAreaEncounter{}
+	data := `[
+		{
+			"location_area": {"name": "route-201-area"},
+			"version_details": [
+				{"version": {"name": "diamond"}, "encounter_details": [
+					{"min_level": 2, "max_level": 3, "chance": 30, "method": {"name": "walk"}},
+					{"min_level": 3, "max_level": 4, "chance": 20, "method": {"name": "walk"}}
+				]},
+				{"version": {"name": "pearl"}, "encounter_details": [
+					{"min_level": 2, "max_level": 2, "chance": 10, "method": {"name": "walk"}}
+				]}
+			]
+		},
+		{
+			"location_area": {"name": "lake-verity-before-galactic-intervention"},
+			"version_details": [
+				{"version": {"name": "diamond"}, "encounter_details": [
+					{"min_level": 20, "max_level": 30, "chance": 5, "method": {"name": "surf"}}
+				]}
+			]
+		}
+	]`
+	if err := json.Unmarshal([]byte(data), &encounters); err != nil {
+		t.Fatal(err)
+	}
+	pokemon := pokeapi.Pokemon{Name: "starly"}
+
+	slots := pokemonSlots(pokemon, encounters, []string{"diamond"}, "")
+	expected := []encounterSlot{
+		{area: "route-201-area", pokemon: "starly", version: "diamond", method: "walk", minLevel: 2, maxLevel: 4, chance: 50},
+		{area: "lake-verity-before-galactic-intervention", pokemon: "starly", version: "diamond", method: "surf", minLevel: 20, maxLevel: 30, chance: 5},
+	}
+	if len(slots) != len(expected) {
+		t.Fatalf("Expecting: %+v\nActual:    %+v", expected, slots)
+	}
+	for i := range slots {
+		if slots[i] != expected[i] {
+			t.Errorf("Expecting: %+v\nActual:    %+v", expected[i], slots[i])
+		}
+	}
+
+	if slots := pokemonSlots(pokemon, encounters, nil, "surf"); len(slots) != 1 {
+		t.Errorf("expected 1 surf slot, got %+v", slots)
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+	"github.com/OferRavid/pokedexcli/internal/render"
+	"github.com/OferRavid/pokedexcli/internal/search"
+)
+
+// failRequest is a fakeAPI response that makes the request fail, as if the
+// connection dropped.
+const failRequest = "<fail>"
+
+// fakeAPI answers PokeAPI requests with fixtures, keyed by the path after
+// /api/v2/ and the query, e.g. "pokemon/pikachu" or
+// "location-area?offset=0&limit=20". Other paths are not found.
+type fakeAPI struct {
+	mu        sync.Mutex
+	responses map[string]string
+	requests  []string
+}
+
+func (f *fakeAPI) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := strings.TrimPrefix(req.URL.Path, "/api/v2/")
+	if req.URL.RawQuery != "" {
+		key += "?" + req.URL.RawQuery
+	}
+	f.mu.Lock()
+	f.requests = append(f.requests, key)
+	body, ok := f.responses[key]
+	f.mu.Unlock()
+
+	status := http.StatusOK
+	switch {
+	case !ok:
+		status, body = http.StatusNotFound, "Not Found"
+	case body == failRequest:
+		return nil, errors.New("connection reset by peer")
+	}
+	return &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+// requested returns the paths requested so far.
+func (f *fakeAPI) requested() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string{}, f.requests...)
+}
+
+// newFakeConfig returns a new game whose PokeAPI requests are answered by api.
+func newFakeConfig(api *fakeAPI) *config {
+	client := pokeapi.NewClient(time.Second, time.Minute, "")
+	client.SetTransport(api)
+	return &config{
+		caughtPokemon:  map[string]pokeapi.Pokemon{},
+		storage:        game.NewStorage(),
+		bag:            game.StarterBag(),
+		money:          game.StartingMoney,
+		farm:           game.NewFarm(),
+		pokedex:        game.NewPokedex(),
+		pokeapiClient:  client,
+		locationsLimit: pokeapi.DefaultPageSize,
+		mode:           gameMode,
+		language:       defaultLanguage,
+		nameIndexes:    map[string]*search.Index{},
+	}
+}
+
+// captureStdout returns what f prints to standard output, including the
+// tables drawn by cfg.ui.
+func captureStdout(t *testing.T, cfg *config, f func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout, ui := os.Stdout, cfg.ui
+	os.Stdout, cfg.ui = w, render.New(w)
+	defer func() { os.Stdout, cfg.ui = stdout, ui }()
+
+	printed := make(chan string)
+	go func() {
+		b, _ := io.ReadAll(r)
+		printed <- string(b)
+	}()
+	f()
+	w.Close()
+	return <-printed
+}
@@ -0,0 +1,38 @@
+package game
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Bag holds the player's items and how many of each they have.
+type Bag map[string]int
+
+// StarterBag returns the items a new player starts with.
+func StarterBag() Bag {
+	return Bag{"poke-ball": 10, "potion": 3, "oran-berry": 2}
+}
+
+// Add puts n of an item in the bag.
+func (b Bag) Add(item string, n int) {
+	b[item] += n
+}
+
+// Remove takes n of an item out of the bag. It fails without changing the
+// bag when there aren't enough.
+func (b Bag) Remove(item string, n int) error {
+	if b[item] < n {
+		return fmt.Errorf("you only have %d %s", b[item], item)
+	}
+	b[item] -= n
+	if b[item] == 0 {
+		delete(b, item)
+	}
+	return nil
+}
+
+// Items returns the names of the items in the bag, sorted.
+func (b Bag) Items() []string {
+	return slices.Sorted(maps.Keys(b))
+}
@@ -0,0 +1,29 @@
+package game
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBag(t *testing.T) {
+	b := Bag{}
+	b.Add("potion", 2)
+	b.Add("poke-ball", 5)
+	b.Add("potion", 1)
+	if b["potion"] != 3 {
+		t.Errorf("expected 3 potions, got %d", b["potion"])
+	}
+
+	if err := b.Remove("poke-ball", 6); err == nil {
+		t.Errorf("expected an error removing more balls than the bag holds")
+	}
+	if b["poke-ball"] != 5 {
+		t.Errorf("expected a failed removal to leave 5 balls, got %d", b["poke-ball"])
+	}
+	if err := b.Remove("poke-ball", 5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if items := b.Items(); !slices.Equal(items, []string{"potion"}) {
+		t.Errorf("expected only potions to be left, got %v", items)
+	}
+}
@@ -0,0 +1,24 @@
+package game
+
+import (
+	"math/rand"
+)
+
+/*
+Damage calculates the damage an attack deals, using the formula of the main
+series games with a random factor between 85% and 100%.
+
+Parameters:
+- level: The attacker's level.
+- power: The attack's power.
+- attack: The attacker's attack or special attack stat.
+- defense: The defender's defense or special defense stat.
+- rng: The source of the random factor.
+
+Returns:
+- int: The damage dealt, at least 1.
+*/
+func Damage(level, power, attack, defense int, rng *rand.Rand) int {
+	damage := (2*level/5+2)*power*attack/max(1, defense)/50 + 2
+	return max(1, damage*(85+rng.Intn(16))/100)
+}
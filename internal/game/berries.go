@@ -0,0 +1,121 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// PlotsPerLocation is the number of soil plots at each location.
+const PlotsPerLocation = 4
+
+// BerryStages are the stages a planted berry grows through. Each stage takes
+// the berry's growth time; berries can be harvested at the last one.
+var BerryStages = []string{"planted", "sprouted", "taller", "flowering", "ripe"}
+
+// Plot is a soil plot with a berry growing in it.
+type Plot struct {
+	Location   string        `json:"location"`
+	Berry      string        `json:"berry"`
+	Item       string        `json:"item"`
+	StageTime  time.Duration `json:"stage_time"`
+	MaxHarvest int           `json:"max_harvest"`
+	PlantedAt  time.Time     `json:"planted_at"`
+}
+
+// Stage returns the index in BerryStages of the stage the berry has reached.
+func (p *Plot) Stage(now time.Time) int {
+	if p.StageTime <= 0 {
+		return len(BerryStages) - 1
+	}
+	stage := int(now.Sub(p.PlantedAt) / p.StageTime)
+	return min(max(stage, 0), len(BerryStages)-1)
+}
+
+// RipeAt returns when the berry can be harvested.
+func (p *Plot) RipeAt() time.Time {
+	return p.PlantedAt.Add(time.Duration(len(BerryStages)-1) * p.StageTime)
+}
+
+// Ripe reports whether the berry can be harvested.
+func (p *Plot) Ripe(now time.Time) bool {
+	return !now.Before(p.RipeAt())
+}
+
+// Farm holds the berries the player has planted.
+type Farm struct {
+	Plots []*Plot `json:"plots"`
+}
+
+// NewFarm creates a Farm with nothing planted.
+func NewFarm() *Farm {
+	return &Farm{Plots: []*Plot{}}
+}
+
+// At returns the plots in use at a location.
+func (f *Farm) At(location string) []*Plot {
+	plots := []*Plot{}
+	for _, p := range f.Plots {
+		if p.Location == location {
+			plots = append(plots, p)
+		}
+	}
+	return plots
+}
+
+/*
+Plant plants a berry in a free soil plot at a location. The berry's growth
+time is the number of hours each stage takes.
+
+Parameters:
+- location: The name of the location.
+- berry: The PokeAPI data of the berry.
+- now: The time of planting.
+
+Returns:
+- *Plot: The plot the berry was planted in.
+- error: An error if every plot at the location is in use.
+*/
+func (f *Farm) Plant(location string, berry pokeapi.Berry, now time.Time) (*Plot, error) {
+	if len(f.At(location)) >= PlotsPerLocation {
+		return nil, fmt.Errorf("all %d soil plots in %s are in use", PlotsPerLocation, location)
+	}
+	plot := &Plot{
+		Location:   location,
+		Berry:      berry.Name,
+		Item:       berry.Item.Name,
+		StageTime:  time.Duration(berry.GrowthTime) * time.Hour,
+		MaxHarvest: berry.MaxHarvest,
+		PlantedAt:  now,
+	}
+	f.Plots = append(f.Plots, plot)
+	return plot, nil
+}
+
+/*
+Harvest picks the ripe berries at a location, freeing their plots. Each plot
+yields between 2 and the berry's maximum harvest.
+
+Parameters:
+- location: The name of the location.
+- now: The time of the harvest.
+- rng: The source of the random yields.
+
+Returns:
+- Bag: The berry items picked and how many of each.
+*/
+func (f *Farm) Harvest(location string, now time.Time, rng *rand.Rand) Bag {
+	picked := Bag{}
+	f.Plots = slices.DeleteFunc(f.Plots, func(p *Plot) bool {
+		if p.Location != location || !p.Ripe(now) {
+			return false
+		}
+		low := min(2, max(1, p.MaxHarvest))
+		picked.Add(p.Item, low+rng.Intn(max(1, p.MaxHarvest-low+1)))
+		return true
+	})
+	return picked
+}
@@ -0,0 +1,47 @@
+package game
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+func TestFarm(t *testing.T) {
+	oran := pokeapi.Berry{Name: "oran", GrowthTime: 4, MaxHarvest: 5, Item: pokeapi.Resource{Name: "oran-berry"}}
+	planted := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	rng := rand.New(rand.NewSource(1))
+
+	f := NewFarm()
+	for range PlotsPerLocation {
+		if _, err := f.Plant("pallet-town", oran, planted); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := f.Plant("pallet-town", oran, planted); err == nil {
+		t.Errorf("expected an error when every plot is in use")
+	}
+	if _, err := f.Plant("viridian-city", oran, planted); err != nil {
+		t.Errorf("expected plots at other locations to be free, got %v", err)
+	}
+
+	plot := f.Plots[0]
+	if stage := plot.Stage(planted.Add(9 * time.Hour)); BerryStages[stage] != "taller" {
+		t.Errorf("expected the berry to be taller after 9 hours, got %s", BerryStages[stage])
+	}
+	if plot.Ripe(planted.Add(15 * time.Hour)) {
+		t.Errorf("expected the berry not to be ripe after 15 hours")
+	}
+
+	if picked := f.Harvest("pallet-town", planted.Add(15*time.Hour), rng); len(picked) != 0 {
+		t.Errorf("expected nothing to harvest yet, got %v", picked)
+	}
+	picked := f.Harvest("pallet-town", planted.Add(16*time.Hour), rng)
+	if n := picked["oran-berry"]; n < 2*PlotsPerLocation || n > 5*PlotsPerLocation {
+		t.Errorf("expected between 2 and 5 berries per plot, got %d", n)
+	}
+	if len(f.At("pallet-town")) != 0 || len(f.At("viridian-city")) != 1 {
+		t.Errorf("expected only the harvested plots to be freed, got %d plots left", len(f.Plots))
+	}
+}
@@ -0,0 +1,96 @@
+package game
+
+import (
+	"slices"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// MaxLevel is the highest level a Pokemon can reach.
+const MaxLevel = 100
+
+// XPForLevel returns the total experience a Pokemon with the given growth
+// rate needs to reach a level.
+func XPForLevel(rate pokeapi.GrowthRate, level int) int {
+	for _, l := range rate.Levels {
+		if l.Level == level {
+			return l.Experience
+		}
+	}
+	return 0
+}
+
+// LevelForXP returns the level a Pokemon with the given growth rate and total
+// experience has reached.
+func LevelForXP(rate pokeapi.GrowthRate, xp int) int {
+	level := 1
+	for _, l := range rate.Levels {
+		if l.Experience <= xp && l.Level > level {
+			level = l.Level
+		}
+	}
+	return level
+}
+
+/*
+XPYield calculates the experience gained for defeating or catching a wild
+Pokemon, using the formula of the main series games before generation V.
+
+Parameters:
+- defeated: The PokeAPI data of the defeated Pokemon's species.
+- level: The defeated Pokemon's level.
+
+Returns:
+- int: The experience gained, at least 1.
+*/
+func XPYield(defeated pokeapi.Pokemon, level int) int {
+	return max(1, defeated.BaseExperience*level/7)
+}
+
+/*
+GainXP adds experience to the Pokemon and raises its level when it has
+enough. HP grows along with the Pokemon's maximum HP, so damage taken before
+leveling up is kept.
+
+Parameters:
+- xp: The experience gained.
+- rate: The growth rate of the Pokemon's species.
+- species: The PokeAPI data of the Pokemon's species.
+
+Returns:
+- []int: The levels reached, in order. Empty if the Pokemon didn't level up.
+*/
+func (p *Pokemon) GainXP(xp int, rate pokeapi.GrowthRate, species pokeapi.Pokemon) []int {
+	p.XP = min(p.XP+xp, XPForLevel(rate, MaxLevel))
+	reached := []int{}
+	for p.Level < MaxLevel && LevelForXP(rate, p.XP) > p.Level {
+		oldMaxHP := p.MaxHP(species)
+		p.Level++
+		if p.CurrentHP > 0 {
+			p.CurrentHP += p.MaxHP(species) - oldMaxHP
+		}
+		reached = append(reached, p.Level)
+	}
+	return reached
+}
+
+// MovesLearnedAt returns the moves a species learns by leveling up to exactly
+// the given level, in any game, sorted by name.
+func MovesLearnedAt(species pokeapi.Pokemon, level int) []string {
+	moves := []string{}
+	for _, move := range species.Moves {
+		for _, details := range move.VersionGroupDetails {
+			if details.MoveLearnMethod.Name == "level-up" && details.LevelLearnedAt == level {
+				moves = append(moves, move.Move.Name)
+				break
+			}
+		}
+	}
+	slices.Sort(moves)
+	return moves
+}
+
+// Knows reports whether the Pokemon knows a move.
+func (p *Pokemon) Knows(move string) bool {
+	return slices.Contains(p.Moves, move)
+}
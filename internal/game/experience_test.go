@@ -0,0 +1,65 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// mediumFast builds the medium-fast growth rate, which needs level^3 experience.
+func mediumFast(t *testing.T) pokeapi.GrowthRate {
+	t.Helper()
+	levels := []string{}
+	for level := 1; level <= MaxLevel; level++ {
+		levels = append(levels, fmt.Sprintf(`{"level": %d, "experience": %d}`, level, level*level*level))
+	}
+	rate := pokeapi.GrowthRate{}
+	if err := json.Unmarshal([]byte(`{"name": "medium", "levels": [`+strings.Join(levels, ",")+`]}`), &rate); err != nil {
+		t.Fatal(err)
+	}
+	return rate
+}
+
+func TestLevelForXP(t *testing.T) {
+	rate := mediumFast(t)
+	cases := []struct {
+		xp       int
+		expected int
+	}{
+		{xp: 0, expected: 1},
+		{xp: 124, expected: 4},
+		{xp: 125, expected: 5},
+		{xp: 2_000_000, expected: MaxLevel},
+	}
+	for _, c := range cases {
+		if actual := LevelForXP(rate, c.xp); actual != c.expected {
+			t.Errorf("LevelForXP(%d): expected %d, got %d", c.xp, c.expected, actual)
+		}
+	}
+}
+
+func TestGainXP(t *testing.T) {
+	rate := mediumFast(t)
+	species := pokeapi.Pokemon{}
+	if err := json.Unmarshal([]byte(`{"stats": [{"base_stat": 35, "stat": {"name": "hp"}}]}`), &species); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Pokemon{Level: 5, XP: XPForLevel(rate, 5)}
+	p.CurrentHP = p.MaxHP(species) - 3
+	reached := p.GainXP(XPForLevel(rate, 7)-p.XP, rate, species)
+	if len(reached) != 2 || reached[0] != 6 || reached[1] != 7 {
+		t.Errorf("expected to reach levels 6 and 7, got %v", reached)
+	}
+	if p.CurrentHP != p.MaxHP(species)-3 {
+		t.Errorf("expected damage to be kept after leveling up, got %d/%d HP", p.CurrentHP, p.MaxHP(species))
+	}
+
+	p.GainXP(10_000_000, rate, species)
+	if p.Level != MaxLevel || p.XP != XPForLevel(rate, MaxLevel) {
+		t.Errorf("expected level and experience to stop at level %d, got level %d with %d XP", MaxLevel, p.Level, p.XP)
+	}
+}
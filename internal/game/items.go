@@ -0,0 +1,145 @@
+package game
+
+import (
+	"strings"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// ballModifiers are the catch rate multipliers of Poke Balls that differ
+// from a plain Poke Ball.
+var ballModifiers = map[string]float64{
+	"great-ball":  1.5,
+	"ultra-ball":  2,
+	"safari-ball": 1.5,
+	"sport-ball":  1.5,
+}
+
+// IsBall reports whether an item category holds Poke Balls.
+func IsBall(category string) bool {
+	return strings.HasSuffix(category, "-balls")
+}
+
+// BallModifier returns how much a Poke Ball multiplies the chance of a catch.
+// The Master Ball never fails, which the caller checks with IsMasterBall.
+func BallModifier(ball string) float64 {
+	if modifier, ok := ballModifiers[ball]; ok {
+		return modifier
+	}
+	return 1
+}
+
+// IsMasterBall reports whether a ball always catches the Pokemon.
+func IsMasterBall(ball string) bool {
+	return ball == "master-ball"
+}
+
+// healAmounts is the HP restored by healing items. 0 restores all HP.
+var healAmounts = map[string]int{
+	"potion":       20,
+	"super-potion": 60,
+	"hyper-potion": 120,
+	"max-potion":   0,
+	"full-restore": 0,
+	"fresh-water":  30,
+	"soda-pop":     50,
+	"lemonade":     70,
+	"moomoo-milk":  100,
+	"berry-juice":  20,
+}
+
+// HealAmount returns the HP an item restores, 0 meaning all of it, and
+// whether the item heals at all.
+func HealAmount(item string) (int, bool) {
+	amount, ok := healAmounts[item]
+	return amount, ok
+}
+
+// IsRevive reports whether an item brings fainted Pokemon back, and whether
+// it restores all of their HP rather than half.
+func IsRevive(item string) (revives, full bool) {
+	switch item {
+	case "revive":
+		return true, false
+	case "max-revive":
+		return true, true
+	}
+	return false, false
+}
+
+/*
+Heal restores up to amount HP to a Pokemon that hasn't fainted, never above
+its maximum. An amount of 0 restores all HP.
+
+Parameters:
+- amount: The HP to restore, or 0 for all of it.
+- species: The PokeAPI data of the Pokemon's species.
+
+Returns:
+- int: The HP restored.
+*/
+func (p *Pokemon) Heal(amount int, species pokeapi.Pokemon) int {
+	maxHP := p.MaxHP(species)
+	if amount == 0 {
+		amount = maxHP
+	}
+	healed := min(amount, maxHP-p.CurrentHP)
+	p.CurrentHP += healed
+	return healed
+}
+
+/*
+EvolutionByItem finds the species a Pokemon evolves into when the given item
+is used on it.
+
+Parameters:
+- chain: The evolution chain of the Pokemon's species.
+- species: The name of the Pokemon's species.
+- item: The name of the item used, e.g. "thunder-stone".
+
+Returns:
+- string: The species it evolves into, or "" if the item has no effect.
+*/
+func EvolutionByItem(chain pokeapi.EvolutionChain, species, item string) string {
+	link := findLink(chain.Chain, species)
+	if link == nil {
+		return ""
+	}
+	for _, next := range link.EvolvesTo {
+		for _, details := range next.EvolutionDetails {
+			if details.Trigger.Name == "use-item" && details.Item != nil && details.Item.Name == item {
+				return next.Species.Name
+			}
+		}
+	}
+	return ""
+}
+
+// findLink returns the link of a species in an evolution chain, or nil if it isn't in it.
+func findLink(link pokeapi.ChainLink, species string) *pokeapi.ChainLink {
+	if link.Species.Name == species {
+		return &link
+	}
+	for _, next := range link.EvolvesTo {
+		if found := findLink(next, species); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+/*
+Evolve turns the Pokemon into the species it evolved into. Damage taken
+before evolving is kept.
+
+Parameters:
+- from: The PokeAPI data of the Pokemon's current species.
+- into: The PokeAPI data of the species it evolves into.
+*/
+func (p *Pokemon) Evolve(from, into pokeapi.Pokemon) {
+	oldMaxHP := p.MaxHP(from)
+	p.Species = into.Name
+	if p.CurrentHP > 0 {
+		p.CurrentHP = max(1, p.CurrentHP+p.MaxHP(into)-oldMaxHP)
+	}
+}
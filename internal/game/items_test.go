@@ -0,0 +1,59 @@
+package game
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+const testChainJSON = `{"id": 67, "chain": {"species": {"name": "eevee"}, "evolves_to": [
+	{"species": {"name": "vaporeon"}, "evolution_details": [{"trigger": {"name": "use-item"}, "item": {"name": "water-stone"}}], "evolves_to": []},
+	{"species": {"name": "espeon"}, "evolution_details": [{"trigger": {"name": "level-up"}, "min_happiness": 160, "time_of_day": "day"}], "evolves_to": []},
+	{"species": {"name": "jolteon"}, "evolution_details": [{"trigger": {"name": "use-item"}, "item": {"name": "thunder-stone"}}], "evolves_to": []}
+]}}`
+
+func TestEvolutionByItem(t *testing.T) {
+	chain := pokeapi.EvolutionChain{}
+	if err := json.Unmarshal([]byte(testChainJSON), &chain); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		species  string
+		item     string
+		expected string
+	}{
+		{species: "eevee", item: "thunder-stone", expected: "jolteon"},
+		{species: "eevee", item: "water-stone", expected: "vaporeon"},
+		{species: "eevee", item: "fire-stone", expected: ""},
+		{species: "jolteon", item: "thunder-stone", expected: ""},
+		{species: "pikachu", item: "thunder-stone", expected: ""},
+	}
+	for _, c := range cases {
+		if actual := EvolutionByItem(chain, c.species, c.item); actual != c.expected {
+			t.Errorf("EvolutionByItem(%s, %s): expected %q, got %q", c.species, c.item, c.expected, actual)
+		}
+	}
+}
+
+func TestHeal(t *testing.T) {
+	species := pokeapi.Pokemon{}
+	if err := json.Unmarshal([]byte(`{"stats": [{"base_stat": 35, "stat": {"name": "hp"}}]}`), &species); err != nil {
+		t.Fatal(err)
+	}
+	p := &Pokemon{Level: 50}
+	maxHP := p.MaxHP(species)
+
+	p.CurrentHP = maxHP - 30
+	if healed := p.Heal(20, species); healed != 20 || p.CurrentHP != maxHP-10 {
+		t.Errorf("expected to heal 20 HP, healed %d to %d/%d", healed, p.CurrentHP, maxHP)
+	}
+	if healed := p.Heal(20, species); healed != 10 || p.CurrentHP != maxHP {
+		t.Errorf("expected to heal the last 10 HP, healed %d to %d/%d", healed, p.CurrentHP, maxHP)
+	}
+	p.CurrentHP = 1
+	if p.Heal(0, species); p.CurrentHP != maxHP {
+		t.Errorf("expected a full heal, got %d/%d", p.CurrentHP, maxHP)
+	}
+}
@@ -0,0 +1,67 @@
+package game
+
+import (
+	"slices"
+	"strings"
+)
+
+// StartingMoney is the money a new player has.
+const StartingMoney = 3000
+
+// martStock lists the items every Poke Mart sells.
+var martStock = []string{"poke-ball", "potion", "antidote", "paralyze-heal", "awakening", "burn-heal", "ice-heal", "escape-rope", "repel"}
+
+// cityStock lists the extra items sold in the Poke Marts of cities, by region.
+// Regions that aren't listed sell defaultCityStock.
+var cityStock = map[string][]string{
+	"kanto":  {"great-ball", "super-potion", "revive", "full-heal"},
+	"johto":  {"great-ball", "ultra-ball", "super-potion", "hyper-potion", "revive"},
+	"hoenn":  {"great-ball", "ultra-ball", "super-potion", "hyper-potion", "revive", "full-heal"},
+	"sinnoh": {"great-ball", "ultra-ball", "super-potion", "hyper-potion", "max-potion", "revive", "full-heal"},
+	"unova":  {"great-ball", "ultra-ball", "super-potion", "hyper-potion", "max-potion", "full-restore", "revive"},
+}
+
+var defaultCityStock = []string{"great-ball", "ultra-ball", "super-potion", "hyper-potion", "revive", "full-heal"}
+
+/*
+MartStock returns the items sold at a location. Towns and cities have a Poke
+Mart; cities sell more, depending on their region.
+
+Parameters:
+- region: The name of the location's region, e.g. "kanto".
+- location: The name of the location, e.g. "celadon-city".
+
+Returns:
+- []string: The items for sale, or nil if there is no Poke Mart.
+*/
+func MartStock(region, location string) []string {
+	isCity := strings.Contains(location, "city")
+	if !isCity && !strings.Contains(location, "town") {
+		return nil
+	}
+
+	stock := slices.Clone(martStock)
+	if isCity {
+		extra, ok := cityStock[region]
+		if !ok {
+			extra = defaultCityStock
+		}
+		stock = append(stock, extra...)
+	}
+	return stock
+}
+
+// SellPrice returns what a Poke Mart pays for an item: half its price.
+func SellPrice(cost int) int {
+	return cost / 2
+}
+
+// BattlePrize returns the money found after defeating a wild Pokemon.
+func BattlePrize(level int) int {
+	return 10 * level
+}
+
+// ReleaseReward returns the money a released Pokemon leaves as thanks.
+func ReleaseReward(level int) int {
+	return 5 * level
+}
@@ -0,0 +1,28 @@
+package game
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMartStock(t *testing.T) {
+	if stock := MartStock("kanto", "route-1"); stock != nil {
+		t.Errorf("expected no Poke Mart on a route, got %v", stock)
+	}
+
+	town := MartStock("kanto", "pallet-town")
+	if !slices.Contains(town, "poke-ball") || slices.Contains(town, "great-ball") {
+		t.Errorf("expected a town to sell only the basic stock, got %v", town)
+	}
+
+	kanto := MartStock("kanto", "celadon-city")
+	if !slices.Contains(kanto, "great-ball") || slices.Contains(kanto, "ultra-ball") {
+		t.Errorf("expected a Kanto city to sell great balls but not ultra balls, got %v", kanto)
+	}
+	if len(MartStock("sinnoh", "jubilife-city")) <= len(kanto) {
+		t.Errorf("expected Sinnoh cities to sell more than Kanto cities")
+	}
+	if stock := MartStock("paldea", "mesagoza-city"); !slices.Contains(stock, "ultra-ball") {
+		t.Errorf("expected other regions to sell the default city stock, got %v", stock)
+	}
+}
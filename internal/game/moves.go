@@ -0,0 +1,56 @@
+package game
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+/*
+MoveIn returns a move with the power, accuracy, PP, effect chance, type and
+effects it had in a version group. PokeAPI lists the values a move had before
+the version group in which they changed, leaving out the values that didn't,
+so every change made after the version group is undone, the newest first.
+
+Parameters:
+- move: The PokeAPI data of the move.
+- orders: The order of each version group named in the move's past values.
+- order: The order of the version group, or 0 for the current values.
+
+Returns:
+- pokeapi.Move: The move as it was in the version group.
+*/
+func MoveIn(move pokeapi.Move, orders map[string]int, order int) pokeapi.Move {
+	if order == 0 {
+		return move
+	}
+	changes := slices.Clone(move.PastValues)
+	slices.SortFunc(changes, func(a, b pokeapi.PastMoveStatValues) int {
+		return cmp.Compare(orders[b.VersionGroup.Name], orders[a.VersionGroup.Name])
+	})
+	for _, past := range changes {
+		if orders[past.VersionGroup.Name] <= order {
+			break
+		}
+		if past.Accuracy != nil {
+			move.Accuracy = past.Accuracy
+		}
+		if past.EffectChance != nil {
+			move.EffectChance = past.EffectChance
+		}
+		if past.Power != nil {
+			move.Power = past.Power
+		}
+		if past.PP != nil {
+			move.PP = past.PP
+		}
+		if past.Type != nil {
+			move.Type = *past.Type
+		}
+		if len(past.EffectEntries) > 0 {
+			move.EffectEntries = past.EffectEntries
+		}
+	}
+	return move
+}
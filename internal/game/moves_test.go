@@ -0,0 +1,49 @@
+package game
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+func TestMoveIn(t *testing.T) {
+	// Tackle was 35 power and 95 accuracy until Black and White, then 50 power
+	// until Sun and Moon. The change of type in Gold and Silver is made up.
+	move := pokeapi.Move{}
+	if err := json.Unmarshal([]byte(`{
+		"name": "tackle", "power": 40, "accuracy": 100, "pp": 35, "type": {"name": "dark"},
+		"past_values": [
+			{"power": 50, "version_group": {"name": "sun-moon"}},
+			{"type": {"name": "normal"}, "version_group": {"name": "gold-silver"}},
+			{"power": 35, "accuracy": 95, "version_group": {"name": "black-white"}}
+		]
+	}`), &move); err != nil {
+		t.Fatal(err)
+	}
+	orders := map[string]int{"red-blue": 1, "gold-silver": 3, "black-white": 11, "x-y": 15, "sun-moon": 17, "sword-shield": 20}
+
+	cases := []struct {
+		versionGroup string
+		power        int
+		accuracy     int
+		typeName     string
+	}{
+		{versionGroup: "", power: 40, accuracy: 100, typeName: "dark"},
+		{versionGroup: "red-blue", power: 35, accuracy: 95, typeName: "normal"},
+		{versionGroup: "gold-silver", power: 35, accuracy: 95, typeName: "dark"},
+		{versionGroup: "black-white", power: 50, accuracy: 100, typeName: "dark"},
+		{versionGroup: "x-y", power: 50, accuracy: 100, typeName: "dark"},
+		{versionGroup: "sword-shield", power: 40, accuracy: 100, typeName: "dark"},
+	}
+	for _, c := range cases {
+		got := MoveIn(move, orders, orders[c.versionGroup])
+		if *got.Power != c.power || *got.Accuracy != c.accuracy || *got.PP != 35 || got.Type.Name != c.typeName {
+			t.Errorf("%q: expected %d power, %d accuracy, 35 PP and %s, got %d, %d, %d and %s",
+				c.versionGroup, c.power, c.accuracy, c.typeName, *got.Power, *got.Accuracy, *got.PP, got.Type.Name)
+		}
+	}
+	if *move.Power != 40 {
+		t.Errorf("expected the move itself to be unchanged, got %d power", *move.Power)
+	}
+}
@@ -0,0 +1,72 @@
+package game
+
+// Pokedex records the Pokemon the player has seen, by name, with their
+// national dex numbers. Caught Pokemon count as seen.
+type Pokedex struct {
+	Seen map[string]int `json:"seen"`
+}
+
+// NewPokedex creates a Pokedex with nothing seen.
+func NewPokedex() *Pokedex {
+	return &Pokedex{Seen: map[string]int{}}
+}
+
+// See records that a Pokemon has been seen.
+func (d *Pokedex) See(name string, number int) {
+	if d.Seen == nil {
+		d.Seen = map[string]int{}
+	}
+	d.Seen[name] = number
+}
+
+// HasSeen reports whether a Pokemon with the given national dex number has been seen.
+func (d *Pokedex) HasSeen(number int) bool {
+	for _, n := range d.Seen {
+		if n == number {
+			return true
+		}
+	}
+	return false
+}
+
+// Completion is how much of a pokedex the player has filled.
+type Completion struct {
+	Seen   int
+	Caught int
+	Total  int
+}
+
+// Percent returns the share of the pokedex that was caught, from 0 to 100.
+func (c Completion) Percent() float64 {
+	if c.Total == 0 {
+		return 0
+	}
+	return float64(c.Caught) * 100 / float64(c.Total)
+}
+
+/*
+Completion counts how many Pokemon of a pokedex were seen and caught.
+
+Parameters:
+- numbers: The national dex numbers of the Pokemon in the pokedex.
+- caught: The national dex numbers of the caught Pokemon.
+
+Returns:
+- Completion: The number of Pokemon seen, caught and in the pokedex.
+*/
+func (d *Pokedex) Completion(numbers []int, caught map[int]bool) Completion {
+	seen := map[int]bool{}
+	for _, n := range d.Seen {
+		seen[n] = true
+	}
+	c := Completion{Total: len(numbers)}
+	for _, n := range numbers {
+		if seen[n] || caught[n] {
+			c.Seen++
+		}
+		if caught[n] {
+			c.Caught++
+		}
+	}
+	return c
+}
@@ -0,0 +1,26 @@
+package game
+
+import "testing"
+
+func TestPokedexCompletion(t *testing.T) {
+	dex := NewPokedex()
+	dex.See("bulbasaur", 1)
+	dex.See("charmander", 4)
+	dex.See("pikachu", 25)
+
+	if !dex.HasSeen(4) || dex.HasSeen(7) {
+		t.Errorf("expected charmander to be seen and squirtle not, got %v", dex.Seen)
+	}
+
+	// Caught Pokemon count as seen even when they weren't recorded.
+	c := dex.Completion([]int{1, 2, 3, 4, 5, 6, 7, 8}, map[int]bool{4: true, 7: true, 25: true})
+	if c.Seen != 3 || c.Caught != 2 || c.Total != 8 {
+		t.Errorf("expected 3 seen and 2 caught of 8, got %+v", c)
+	}
+	if got := c.Percent(); got != 25 {
+		t.Errorf("expected 25%% completion, got %v", got)
+	}
+	if got := (Completion{}).Percent(); got != 0 {
+		t.Errorf("expected an empty pokedex to be 0%% complete, got %v", got)
+	}
+}
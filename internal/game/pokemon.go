@@ -0,0 +1,231 @@
+package game
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// MaxMoves is the number of moves a Pokemon can know at once.
+const MaxMoves = 4
+
+// Limits of individual values (IVs) and effort values (EVs).
+const (
+	MaxIV       = 31
+	MaxEV       = 252 // per stat
+	MaxTotalEVs = 510
+)
+
+// StatNames lists the stats every Pokemon has, in the order the games show them.
+var StatNames = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+// Stats holds a value for each stat, keyed by stat name.
+type Stats map[string]int
+
+// Total returns the sum of all the stats.
+func (s Stats) Total() int {
+	total := 0
+	for _, value := range s {
+		total += value
+	}
+	return total
+}
+
+// Nature raises one stat by 10% and lowers another by 10%. Neutral natures
+// leave both empty.
+type Nature struct {
+	Name      string `json:"name"`
+	Increased string `json:"increased,omitempty"`
+	Decreased string `json:"decreased,omitempty"`
+}
+
+// NewNature builds a Nature from its PokeAPI data.
+func NewNature(nature pokeapi.Nature) Nature {
+	n := Nature{Name: nature.Name}
+	// Neutral natures raise and lower the same stat, which cancels out.
+	if nature.IncreasedStat != nil && nature.DecreasedStat != nil && nature.IncreasedStat.Name != nature.DecreasedStat.Name {
+		n.Increased = nature.IncreasedStat.Name
+		n.Decreased = nature.DecreasedStat.Name
+	}
+	return n
+}
+
+// Modifier returns the nature's effect on a stat in percent: 110, 90 or 100.
+func (n Nature) Modifier(stat string) int {
+	switch {
+	case stat == "":
+		return 100
+	case stat == n.Increased:
+		return 110
+	case stat == n.Decreased:
+		return 90
+	}
+	return 100
+}
+
+// Pokemon is a Pokemon owned by the player. Several Pokemon of the same
+// species are told apart by their ID.
+type Pokemon struct {
+	ID        int       `json:"id"`
+	Species   string    `json:"species"`
+	Nickname  string    `json:"nickname,omitempty"`
+	Level     int       `json:"level"`
+	XP        int       `json:"xp"`
+	CurrentHP int       `json:"current_hp"`
+	Moves     []string  `json:"moves"`
+	Nature    Nature    `json:"nature"`
+	IVs       Stats     `json:"ivs"`
+	EVs       Stats     `json:"evs"`
+	CaughtAt  string    `json:"caught_at"`
+	CaughtOn  time.Time `json:"caught_on"`
+}
+
+/*
+NewPokemon creates a freshly caught Pokemon at full health, knowing the last
+moves its species learns by leveling up to its level. Its IVs are random and
+it has no EVs yet. The ID is assigned when the Pokemon is added to the
+player's storage.
+
+Parameters:
+- species: The PokeAPI data of the Pokemon's species.
+- level: The Pokemon's level.
+- location: The location area it was caught in.
+- nature: The Pokemon's nature.
+- rng: The source of the random IVs.
+
+Returns:
+- *Pokemon: The new Pokemon.
+*/
+func NewPokemon(species pokeapi.Pokemon, level int, location string, nature Nature, rng *rand.Rand) *Pokemon {
+	p := &Pokemon{
+		Species:  species.Name,
+		Level:    level,
+		Moves:    StartingMoves(species, level),
+		Nature:   nature,
+		IVs:      Stats{},
+		EVs:      Stats{},
+		CaughtAt: location,
+		CaughtOn: time.Now(),
+	}
+	for _, stat := range StatNames {
+		p.IVs[stat] = rng.Intn(MaxIV + 1)
+		p.EVs[stat] = 0
+	}
+	p.CurrentHP = p.MaxHP(species)
+	return p
+}
+
+// Name returns the Pokemon's nickname, or its species name if it has none.
+func (p *Pokemon) Name() string {
+	if p.Nickname != "" {
+		return p.Nickname
+	}
+	return p.Species
+}
+
+/*
+StartingMoves returns the moves a Pokemon of the given species knows when met
+at the given level: the last MaxMoves moves learned by leveling up, in the
+order they are learned.
+*/
+func StartingMoves(species pokeapi.Pokemon, level int) []string {
+	type learned struct {
+		name  string
+		level int
+	}
+	moves := []learned{}
+	for _, move := range species.Moves {
+		// A move may be learned at different levels in different games;
+		// the earliest one counts.
+		best := -1
+		for _, details := range move.VersionGroupDetails {
+			if details.MoveLearnMethod.Name != "level-up" || details.LevelLearnedAt > level {
+				continue
+			}
+			if best < 0 || details.LevelLearnedAt < best {
+				best = details.LevelLearnedAt
+			}
+		}
+		if best >= 0 {
+			moves = append(moves, learned{name: move.Move.Name, level: best})
+		}
+	}
+	slices.SortStableFunc(moves, func(a, b learned) int {
+		return cmp.Compare(a.level, b.level)
+	})
+
+	names := []string{}
+	for _, move := range moves[max(0, len(moves)-MaxMoves):] {
+		names = append(names, move.name)
+	}
+	return names
+}
+
+/*
+StatValue calculates a stat of a Pokemon using the formulas of the main
+series games.
+
+Parameters:
+- stat: The stat name, e.g. "hp" or "speed".
+- base: The species' base stat.
+- iv: The Pokemon's individual value for the stat, 0 to MaxIV.
+- ev: The Pokemon's effort value for the stat, 0 to MaxEV.
+- level: The Pokemon's level.
+- nature: The Pokemon's nature, which doesn't affect HP.
+
+Returns:
+- int: The stat value.
+*/
+func StatValue(stat string, base, iv, ev, level int, nature Nature) int {
+	value := (2*base + iv + ev/4) * level / 100
+	if stat == "hp" {
+		return value + level + 10
+	}
+	return (value + 5) * nature.Modifier(stat) / 100
+}
+
+// BaseStats returns a species' base stats.
+func BaseStats(species pokeapi.Pokemon) Stats {
+	stats := Stats{}
+	for _, s := range species.Stats {
+		stats[s.Stat.Name] = s.BaseStat
+	}
+	return stats
+}
+
+// Stats returns the Pokemon's stats at its current level.
+func (p *Pokemon) Stats(species pokeapi.Pokemon) Stats {
+	base := BaseStats(species)
+	stats := Stats{}
+	for _, stat := range StatNames {
+		stats[stat] = StatValue(stat, base[stat], p.IVs[stat], p.EVs[stat], p.Level, p.Nature)
+	}
+	return stats
+}
+
+// MaxHP returns the Pokemon's HP when fully healed.
+func (p *Pokemon) MaxHP(species pokeapi.Pokemon) int {
+	return p.Stats(species)["hp"]
+}
+
+/*
+GainEVs adds the effort values a defeated Pokemon yields, keeping each stat
+within MaxEV and the total within MaxTotalEVs.
+
+Parameters:
+- defeated: The PokeAPI data of the defeated Pokemon's species.
+*/
+func (p *Pokemon) GainEVs(defeated pokeapi.Pokemon) {
+	if p.EVs == nil {
+		p.EVs = Stats{}
+	}
+	for _, s := range defeated.Stats {
+		gain := min(s.Effort, MaxEV-p.EVs[s.Stat.Name], MaxTotalEVs-p.EVs.Total())
+		if gain > 0 {
+			p.EVs[s.Stat.Name] += gain
+		}
+	}
+}
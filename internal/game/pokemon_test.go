@@ -0,0 +1,72 @@
+package game
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+func TestStatValue(t *testing.T) {
+	// A level 78 Garchomp with an adamant nature.
+	adamant := Nature{Name: "adamant", Increased: "attack", Decreased: "special-attack"}
+	cases := []struct {
+		stat     string
+		base     int
+		iv       int
+		ev       int
+		expected int
+	}{
+		{stat: "hp", base: 108, iv: 24, ev: 74, expected: 289},
+		{stat: "attack", base: 130, iv: 12, ev: 190, expected: 278},
+		{stat: "defense", base: 95, iv: 30, ev: 91, expected: 193},
+		{stat: "special-attack", base: 80, iv: 16, ev: 48, expected: 135},
+		{stat: "speed", base: 102, iv: 5, ev: 23, expected: 171},
+	}
+	for _, c := range cases {
+		if actual := StatValue(c.stat, c.base, c.iv, c.ev, 78, adamant); actual != c.expected {
+			t.Errorf("StatValue(%s): expected %d, got %d", c.stat, c.expected, actual)
+		}
+	}
+}
+
+func TestNewNature(t *testing.T) {
+	neutral := pokeapi.Nature{
+		Name:          "hardy",
+		IncreasedStat: &pokeapi.Resource{Name: "attack"},
+		DecreasedStat: &pokeapi.Resource{Name: "attack"},
+	}
+	if n := NewNature(neutral); n.Modifier("attack") != 100 {
+		t.Errorf("expected a neutral nature to leave attack alone, got %d%%", n.Modifier("attack"))
+	}
+
+	bold := pokeapi.Nature{
+		Name:          "bold",
+		IncreasedStat: &pokeapi.Resource{Name: "defense"},
+		DecreasedStat: &pokeapi.Resource{Name: "attack"},
+	}
+	n := NewNature(bold)
+	if n.Modifier("defense") != 110 || n.Modifier("attack") != 90 || n.Modifier("speed") != 100 {
+		t.Errorf("unexpected modifiers for %+v", n)
+	}
+}
+
+func TestGainEVs(t *testing.T) {
+	defeated := pokeapi.Pokemon{}
+	data := `{"stats": [{"base_stat": 45, "effort": 2, "stat": {"name": "speed"}}, {"base_stat": 60, "effort": 1, "stat": {"name": "attack"}}]}`
+	if err := json.Unmarshal([]byte(data), &defeated); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Pokemon{EVs: Stats{"speed": MaxEV - 1}}
+	p.GainEVs(defeated)
+	if p.EVs["speed"] != MaxEV || p.EVs["attack"] != 1 {
+		t.Errorf("expected speed capped at %d and 1 attack, got %v", MaxEV, p.EVs)
+	}
+
+	p = &Pokemon{EVs: Stats{"hp": MaxEV, "defense": MaxEV, "speed": MaxTotalEVs - 2*MaxEV - 1}}
+	p.GainEVs(defeated)
+	if total := p.EVs.Total(); total != MaxTotalEVs {
+		t.Errorf("expected the total to be capped at %d, got %d", MaxTotalEVs, total)
+	}
+}
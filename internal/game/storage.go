@@ -0,0 +1,205 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+const (
+	// PartySize is the number of Pokemon the player can carry.
+	PartySize = 6
+	// BoxCount is the number of PC boxes.
+	BoxCount = 8
+	// BoxSize is the number of Pokemon each PC box holds.
+	BoxSize = 30
+)
+
+// Storage holds the player's Pokemon: the party they carry and the PC boxes.
+type Storage struct {
+	Party  []*Pokemon   `json:"party"`
+	Boxes  [][]*Pokemon `json:"boxes"`
+	NextID int          `json:"next_id"`
+}
+
+/*
+NewStorage creates an empty Storage with an empty party and BoxCount empty boxes.
+
+Returns:
+- *Storage: A new Storage instance.
+*/
+func NewStorage() *Storage {
+	return &Storage{
+		Party:  []*Pokemon{},
+		Boxes:  make([][]*Pokemon, BoxCount),
+		NextID: 1,
+	}
+}
+
+/*
+Add gives a new Pokemon an ID and stores it in the party, or in the first PC
+box with room when the party is full.
+
+Parameters:
+- p: The Pokemon to store.
+
+Returns:
+- int: The box the Pokemon was sent to (1-based), or 0 if it joined the party.
+- error: An error if the party and every box are full.
+*/
+func (s *Storage) Add(p *Pokemon) (int, error) {
+	if len(s.Party) < PartySize {
+		p.ID = s.nextID()
+		s.Party = append(s.Party, p)
+		return 0, nil
+	}
+	for i := range s.Boxes {
+		if len(s.Boxes[i]) < BoxSize {
+			p.ID = s.nextID()
+			s.Boxes[i] = append(s.Boxes[i], p)
+			return i + 1, nil
+		}
+	}
+	return 0, errors.New("your party and PC boxes are full")
+}
+
+func (s *Storage) nextID() int {
+	id := s.NextID
+	s.NextID++
+	return id
+}
+
+/*
+Find looks up an owned Pokemon by ID.
+
+Parameters:
+- id: The Pokemon's ID.
+
+Returns:
+- *Pokemon: The Pokemon, or nil if there is none with that ID.
+- int: The box holding it (1-based), or 0 if it is in the party.
+*/
+func (s *Storage) Find(id int) (*Pokemon, int) {
+	for _, p := range s.Party {
+		if p.ID == id {
+			return p, 0
+		}
+	}
+	for i, box := range s.Boxes {
+		for _, p := range box {
+			if p.ID == id {
+				return p, i + 1
+			}
+		}
+	}
+	return nil, 0
+}
+
+/*
+Deposit moves a party Pokemon into a PC box. The last Pokemon in the party
+can't be deposited.
+
+Parameters:
+- id: The ID of the Pokemon to deposit.
+- box: The box to deposit into (1-based), or 0 for the first box with room.
+
+Returns:
+- int: The box the Pokemon was deposited into.
+- error: An error if the Pokemon isn't in the party or the box is full.
+*/
+func (s *Storage) Deposit(id, box int) (int, error) {
+	i := slices.IndexFunc(s.Party, func(p *Pokemon) bool { return p.ID == id })
+	if i < 0 {
+		return 0, errors.New("that Pokemon isn't in your party")
+	}
+	if len(s.Party) == 1 {
+		return 0, errors.New("you can't deposit your last party Pokemon")
+	}
+	if box < 0 || box > len(s.Boxes) {
+		return 0, fmt.Errorf("there is no box %d. choose a box from 1 to %d", box, len(s.Boxes))
+	}
+	if box == 0 {
+		box = slices.IndexFunc(s.Boxes, func(b []*Pokemon) bool { return len(b) < BoxSize }) + 1
+		if box == 0 {
+			return 0, errors.New("all PC boxes are full")
+		}
+	}
+	if len(s.Boxes[box-1]) >= BoxSize {
+		return 0, fmt.Errorf("box %d is full", box)
+	}
+
+	s.Boxes[box-1] = append(s.Boxes[box-1], s.Party[i])
+	s.Party = slices.Delete(s.Party, i, i+1)
+	return box, nil
+}
+
+/*
+Withdraw moves a Pokemon from a PC box into the party.
+
+Parameters:
+- id: The ID of the Pokemon to withdraw.
+
+Returns:
+- error: An error if the Pokemon isn't in a box or the party is full.
+*/
+func (s *Storage) Withdraw(id int) error {
+	p, box := s.Find(id)
+	if p == nil || box == 0 {
+		return errors.New("that Pokemon isn't in a PC box")
+	}
+	if len(s.Party) >= PartySize {
+		return errors.New("your party is full. deposit a Pokemon first")
+	}
+
+	s.Boxes[box-1] = slices.DeleteFunc(s.Boxes[box-1], func(b *Pokemon) bool { return b.ID == id })
+	s.Party = append(s.Party, p)
+	return nil
+}
+
+/*
+Release removes a Pokemon from the party or PC for good. The last Pokemon in
+the party can't be released.
+
+Parameters:
+- id: The ID of the Pokemon to release.
+
+Returns:
+- *Pokemon: The released Pokemon.
+- error: An error if the Pokemon isn't owned or is the last one in the party.
+*/
+func (s *Storage) Release(id int) (*Pokemon, error) {
+	p, box := s.Find(id)
+	if p == nil {
+		return nil, fmt.Errorf("you don't have a Pokemon with ID %d", id)
+	}
+	isTarget := func(b *Pokemon) bool { return b.ID == id }
+	if box == 0 {
+		if len(s.Party) == 1 {
+			return nil, errors.New("you can't release your last party Pokemon")
+		}
+		s.Party = slices.DeleteFunc(s.Party, isTarget)
+	} else {
+		s.Boxes[box-1] = slices.DeleteFunc(s.Boxes[box-1], isTarget)
+	}
+	return p, nil
+}
+
+// All returns every owned Pokemon, party first and then box by box.
+func (s *Storage) All() []*Pokemon {
+	all := slices.Clone(s.Party)
+	for _, box := range s.Boxes {
+		all = append(all, box...)
+	}
+	return all
+}
+
+// Count returns the number of owned Pokemon of a species.
+func (s *Storage) Count(species string) int {
+	n := 0
+	for _, p := range s.All() {
+		if p.Species == species {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,79 @@
+package game
+
+import (
+	"testing"
+)
+
+func TestStorageAdd(t *testing.T) {
+	s := NewStorage()
+	for i := range PartySize + 2 {
+		box, err := s.Add(&Pokemon{Species: "pikachu"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expectedBox := 0
+		if i >= PartySize {
+			expectedBox = 1
+		}
+		if box != expectedBox {
+			t.Errorf("Pokemon %d: expected box %d, got %d", i+1, expectedBox, box)
+		}
+	}
+
+	if len(s.Party) != PartySize || len(s.Boxes[0]) != 2 {
+		t.Errorf("expected a full party and 2 boxed Pokemon, got %d and %d", len(s.Party), len(s.Boxes[0]))
+	}
+	if p, _ := s.Find(PartySize + 2); p == nil {
+		t.Errorf("expected IDs to be assigned in order")
+	}
+	if n := s.Count("pikachu"); n != PartySize+2 {
+		t.Errorf("expected %d pikachu, got %d", PartySize+2, n)
+	}
+}
+
+func TestStorageDepositWithdraw(t *testing.T) {
+	s := NewStorage()
+	s.Add(&Pokemon{Species: "pikachu"})
+	s.Add(&Pokemon{Species: "staryu"})
+
+	if _, err := s.Deposit(3, 0); err == nil {
+		t.Errorf("expected an error depositing a Pokemon that isn't owned")
+	}
+	box, err := s.Deposit(2, 3)
+	if err != nil || box != 3 {
+		t.Fatalf("expected staryu in box 3, got box %d, %v", box, err)
+	}
+	if _, err := s.Deposit(1, 0); err == nil {
+		t.Errorf("expected an error depositing the last party Pokemon")
+	}
+	if _, box := s.Find(2); box != 3 {
+		t.Errorf("expected to find staryu in box 3, got %d", box)
+	}
+
+	if err := s.Withdraw(1); err == nil {
+		t.Errorf("expected an error withdrawing a party Pokemon")
+	}
+	if err := s.Withdraw(2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(s.Party) != 2 || len(s.Boxes[2]) != 0 {
+		t.Errorf("expected staryu back in the party")
+	}
+}
+
+func TestStorageRelease(t *testing.T) {
+	s := NewStorage()
+	s.Add(&Pokemon{Species: "pikachu"})
+	s.Add(&Pokemon{Species: "staryu"})
+
+	p, err := s.Release(2)
+	if err != nil || p.Species != "staryu" {
+		t.Fatalf("expected to release staryu, got %v, %v", p, err)
+	}
+	if _, err := s.Release(1); err == nil {
+		t.Errorf("expected an error releasing the last party Pokemon")
+	}
+	if _, err := s.Release(2); err == nil {
+		t.Errorf("expected an error releasing a Pokemon twice")
+	}
+}
@@ -0,0 +1,121 @@
+package game
+
+import (
+	"strings"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// romanNumerals are the numerals used in generation names.
+var romanNumerals = map[rune]int{'i': 1, 'v': 5, 'x': 10}
+
+// GenerationNumber returns the number of a generation, e.g. 4 for
+// "generation-iv", or 0 if the name isn't a generation.
+func GenerationNumber(name string) int {
+	numeral, ok := strings.CutPrefix(name, "generation-")
+	if !ok || numeral == "" {
+		return 0
+	}
+	n, prev := 0, 0
+	for i := len(numeral) - 1; i >= 0; i-- {
+		value, ok := romanNumerals[rune(numeral[i])]
+		if !ok {
+			return 0
+		}
+		if value < prev {
+			n -= value
+		} else {
+			n += value
+			prev = value
+		}
+	}
+	return n
+}
+
+/*
+pastEntry picks which of a resource's past entries applies in a generation.
+PokeAPI lists the values a resource had up to and including a generation
+when they changed later, so the entry of the earliest generation that isn't
+older than the given one applies. It returns -1 when the current values apply.
+*/
+func pastEntry(generations []string, generation string) int {
+	target := GenerationNumber(generation)
+	if target == 0 {
+		return -1
+	}
+	picked, pickedNumber := -1, 0
+	for i, g := range generations {
+		n := GenerationNumber(g)
+		if n >= target && (picked == -1 || n < pickedNumber) {
+			picked, pickedNumber = i, n
+		}
+	}
+	return picked
+}
+
+// TypesIn returns the names of a Pokemon's types in a generation, or its
+// current types when the generation is empty.
+func TypesIn(pokemon pokeapi.Pokemon, generation string) []string {
+	generations := []string{}
+	for _, past := range pokemon.PastTypes {
+		generations = append(generations, past.Generation.Name)
+	}
+	types := pokemon.Types
+	if i := pastEntry(generations, generation); i >= 0 {
+		types = pokemon.PastTypes[i].Types
+	}
+	names := []string{}
+	for _, t := range types {
+		names = append(names, t.Type.Name)
+	}
+	return names
+}
+
+// DamageRelationsIn returns a type's damage relations in a generation, or its
+// current ones when the generation is empty.
+func DamageRelationsIn(t pokeapi.Type, generation string) pokeapi.DamageRelations {
+	generations := []string{}
+	for _, past := range t.PastDamageRelations {
+		generations = append(generations, past.Generation.Name)
+	}
+	if i := pastEntry(generations, generation); i >= 0 {
+		return t.PastDamageRelations[i].DamageRelations
+	}
+	return t.DamageRelations
+}
+
+/*
+Matchups calculates how much damage a Pokemon with the given types takes from
+attacks of each type. The multipliers of its types are multiplied together,
+so a type can be doubly effective (4) or doubly resisted (0.25).
+
+Parameters:
+- defending: The PokeAPI data of the Pokemon's types.
+- generation: The generation whose damage relations apply, or "" for the current ones.
+
+Returns:
+- map[string]float64: The multiplier of every type that doesn't deal normal damage.
+*/
+func Matchups(defending []pokeapi.Type, generation string) map[string]float64 {
+	multipliers := map[string]float64{}
+	apply := func(attackers []pokeapi.Resource, factor float64) {
+		for _, attacker := range attackers {
+			if _, ok := multipliers[attacker.Name]; !ok {
+				multipliers[attacker.Name] = 1
+			}
+			multipliers[attacker.Name] *= factor
+		}
+	}
+	for _, t := range defending {
+		relations := DamageRelationsIn(t, generation)
+		apply(relations.DoubleDamageFrom, 2)
+		apply(relations.HalfDamageFrom, 0.5)
+		apply(relations.NoDamageFrom, 0)
+	}
+	for name, m := range multipliers {
+		if m == 1 {
+			delete(multipliers, name)
+		}
+	}
+	return multipliers
+}
@@ -0,0 +1,99 @@
+package game
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// testType builds a type that takes double, half and no damage from the given types.
+func testType(name string, double, half, none []string) pokeapi.Type {
+	resources := func(names []string) []pokeapi.Resource {
+		r := []pokeapi.Resource{}
+		for _, n := range names {
+			r = append(r, pokeapi.Resource{Name: n})
+		}
+		return r
+	}
+	return pokeapi.Type{
+		Name: name,
+		DamageRelations: pokeapi.DamageRelations{
+			DoubleDamageFrom: resources(double),
+			HalfDamageFrom:   resources(half),
+			NoDamageFrom:     resources(none),
+		},
+	}
+}
+
+func TestMatchups(t *testing.T) {
+	water := testType("water", []string{"electric", "grass"}, []string{"fire", "water", "ice", "steel"}, nil)
+	ground := testType("ground", []string{"water", "grass", "ice"}, []string{"poison", "rock"}, []string{"electric"})
+
+	expected := map[string]float64{
+		"electric": 2, "grass": 2,
+		"fire": 0.5, "water": 0.5, "ice": 0.5, "steel": 0.5,
+	}
+	if got := Matchups([]pokeapi.Type{water}, ""); !maps.Equal(got, expected) {
+		t.Errorf("expected %v for water, got %v", expected, got)
+	}
+
+	// Quagsire: water cancels out ground's ice and water weaknesses.
+	expected = map[string]float64{
+		"grass": 4, "electric": 0,
+		"fire": 0.5, "steel": 0.5, "poison": 0.5, "rock": 0.5,
+	}
+	if got := Matchups([]pokeapi.Type{water, ground}, ""); !maps.Equal(got, expected) {
+		t.Errorf("expected %v for water/ground, got %v", expected, got)
+	}
+}
+
+func TestGenerationNumber(t *testing.T) {
+	cases := map[string]int{
+		"generation-i": 1, "generation-iv": 4, "generation-v": 5,
+		"generation-vi": 6, "generation-ix": 9, "generation-viii": 8,
+		"kanto": 0, "generation-": 0, "generation-q": 0,
+	}
+	for name, want := range cases {
+		if got := GenerationNumber(name); got != want {
+			t.Errorf("GenerationNumber(%q) = %d, want %d", name, got, want)
+		}
+	}
+}
+
+func TestTypesIn(t *testing.T) {
+	// Clefairy was normal until fairy was added in generation VI.
+	clefairy := pokeapi.Pokemon{}
+	data := `{
+		"name": "clefairy",
+		"types": [{"slot": 1, "type": {"name": "fairy"}}],
+		"past_types": [{"generation": {"name": "generation-v"}, "types": [{"slot": 1, "type": {"name": "normal"}}]}]
+	}`
+	if err := json.Unmarshal([]byte(data), &clefairy); err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]string{"": "fairy", "generation-i": "normal", "generation-v": "normal", "generation-vi": "fairy"}
+	for generation, want := range cases {
+		if got := TypesIn(clefairy, generation); !slices.Equal(got, []string{want}) {
+			t.Errorf("TypesIn(clefairy, %q) = %v, want [%s]", generation, got, want)
+		}
+	}
+
+	// Steel resisted ghost and dark until generation VI.
+	steel := testType("steel", []string{"fire"}, []string{"normal"}, nil)
+	steel.PastDamageRelations = append(steel.PastDamageRelations, struct {
+		Generation      pokeapi.Resource        `json:"generation"`
+		DamageRelations pokeapi.DamageRelations `json:"damage_relations"`
+	}{
+		Generation:      pokeapi.Resource{Name: "generation-v"},
+		DamageRelations: testType("steel", []string{"fire"}, []string{"normal", "ghost", "dark"}, nil).DamageRelations,
+	})
+	if got := Matchups([]pokeapi.Type{steel}, "generation-iv"); got["ghost"] != 0.5 {
+		t.Errorf("expected steel to resist ghost in generation IV, got %v", got)
+	}
+	if got := Matchups([]pokeapi.Type{steel}, "generation-vi"); got["ghost"] != 0 || got["normal"] != 0.5 {
+		t.Errorf("expected ghost to be neutral on steel in generation VI, got %v", got)
+	}
+}
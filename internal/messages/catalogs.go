@@ -0,0 +1,886 @@
+package messages
+
+// catalogs maps language codes to translations of the CLI's messages, keyed
+// by the English format string. Translations must keep the verbs of the
+// English message and its trailing newline.
+var catalogs = map[string]map[string]string{
+	"de": {
+		"Welcome to the Pokedex!":                                 "Willkommen im Pokédex!",
+		"Usage:":                                                  "Verwendung:",
+		"Closing the Pokedex... Goodbye!":                         "Pokédex wird geschlossen... Auf Wiedersehen!",
+		"Exploring %s...\n":                                       "Erkunde %s...\n",
+		"Found Pokemon: ":                                         "Gefundene Pokémon: ",
+		"No Pokemon found.":                                       "Keine Pokémon gefunden.",
+		"%s can be found in:\n":                                   "%s ist zu finden in:\n",
+		"A wild %s (level %d) appeared in %s!\n":                  "Ein wildes %s (Level %d) ist in %s erschienen!\n",
+		"Throw a Pokeball at it with the catch command.":          "Wirf mit dem Befehl catch einen Pokéball danach.",
+		"Throwing a %s at %s...\n":                                "Du wirfst einen %s auf %s...\n",
+		"%s (level %d) was caught!\n":                             "%s (Level %d) wurde gefangen!\n",
+		"Your party is full, so %s (ID %d) was sent to box %d.\n": "Dein Team ist voll, also wurde %s (ID %d) in Box %d geschickt.\n",
+		"%s (ID %d) joined your party.\n":                         "%s (ID %d) ist deinem Team beigetreten.\n",
+		"You may now inspect it with the inspect command.":        "Du kannst es jetzt mit dem Befehl inspect ansehen.",
+		"%s escaped!\n":                                           "%s ist entkommen!\n",
+		"Name: %s":                                                "Name: %s",
+		"ID: %d\nLevel: %d (%d XP)\n":                             "ID: %d\nLevel: %d (%d EP)\n",
+		"Nature: %s\n":                                            "Wesen: %s\n",
+		"Nature: %s (+%s, -%s)\n":                                 "Wesen: %s (+%s, -%s)\n",
+		"Height: %d\nWeight: %d\n":                                "Größe: %d\nGewicht: %d\n",
+		"Types: %s\n":                                             "Typen: %s\n",
+		"Abilities: %s\n":                                         "Fähigkeiten: %s\n",
+		"%s (hidden)":                                             "%s (versteckt)",
+		"Showing data from %s.\n":                                 "Zeige Daten aus %s.\n",
+		"Showing data from every game.":                           "Zeige Daten aus allen Spielen.",
+		"Showing names and messages in %s.\n":                     "Zeige Namen und Meldungen auf %s.\n",
+		"Pokemon":                                                 "Pokémon",
+		"Area":                                                    "Gebiet",
+		"Stat":                                                    "Wert",
+		"Base":                                                    "Basis",
+		"%d %s cost %s, but you only have %s":                     "%d %s kosten %s, aber du hast nur %s",
+		"%d locations in %s":                                      "%d Orte in %s",
+		"%s %d base, %d at level %d\n":                            "%s %d Basis, %d auf Level %d\n",
+		"%s %q not found":                                         "%s %q nicht gefunden",
+		"%s %q not found. did you mean %s?":                       "%s %q nicht gefunden. meintest du %s?",
+		"%s attacks %s for %d damage. (%d/%d HP left)\n":          "%s greift %s an und verursacht %d Schaden. (%d/%d KP übrig)\n",
+		"%s can't be found in the wild":                           "%s ist nicht in freier Wildbahn zu finden",
+		"%s can't be found that way. try without --version and --method": "%s ist so nicht zu finden. versuche es ohne --version und --method",
+		"%s can't be sold":                                                      "%s kann nicht verkauft werden",
+		"%s can't be used on a Pokemon":                                         "%s kann nicht bei einem Pokémon eingesetzt werden",
+		"%s did not learn %s.\n":                                                "%s hat %s nicht erlernt.\n",
+		"%s fainted! The wild %s is still here.\n":                              "%s wurde besiegt! Das wilde %s ist noch da.\n",
+		"%s gained %d XP.\n":                                                    "%s erhält %d EP.\n",
+		"%s grew to level %d!\n":                                                "%s erreicht Level %d!\n",
+		"%s has fainted and can't battle":                                       "%s ist besiegt und kann nicht kämpfen",
+		"%s has fainted. use a revive first":                                    "%s ist besiegt. setze zuerst eine Beleber ein",
+		"%s has no areas to explore":                                            "%s hat keine Gebiete zum Erkunden",
+		"%s has no pokedex":                                                     "%s hat keinen Pokédex",
+		"%s is in box %d. withdraw it to battle":                                "%s ist in Box %d. nimm es heraus, um zu kämpfen",
+		"%s is not a Poke Ball":                                                 "%s ist kein Pokéball",
+		"%s is now called %s.\n":                                                "%s heißt jetzt %s.\n",
+		"%s joined your party.\n":                                               "%s ist deinem Team beigetreten.\n",
+		"%s learned %s!\n":                                                      "%s hat %s erlernt!\n",
+		"%s recovered %d HP. (%d/%d HP)\n":                                      "%s hat %d KP wiederhergestellt. (%d/%d KP)\n",
+		"%s wants to learn %s, but it already knows %d moves:\n":                "%s möchte %s erlernen, kennt aber schon %d Attacken:\n",
+		"%s was deposited in box %d.\n":                                         "%s wurde in Box %d abgelegt.\n",
+		"%s was released. Bye, %s!\n":                                           "%s wurde freigelassen. Tschüss, %s!\n",
+		"%s was revived! (%d/%d HP)\n":                                          "%s wurde wiederbelebt! (%d/%d KP)\n",
+		"%s's nickname was removed.\n":                                          "Der Spitzname von %s wurde entfernt.\n",
+		"%w. look up any Pokemon with: lookup %s":                               "%w. schlage jedes Pokémon nach mit: lookup %s",
+		"--min-stat takes stat=value pairs, like speed=100. stats are: %s, bst": "--min-stat erwartet Paare wert=zahl, z. B. speed=100. werte sind: %s, bst",
+		"--missing lists Pokemon you haven't caught, so it can't be filtered or sorted": "--missing listet Pokémon, die du nicht gefangen hast, und kann daher nicht gefiltert oder sortiert werden",
+		"1, 2, and... Poof! %s forgot %s and learned %s!\n":                             "1, 2 und... Schwupp! %s hat %s vergessen und %s erlernt!\n",
+		"Abilities":                       "Fähigkeiten",
+		"Ability: %s":                     "Fähigkeit: %s",
+		"Accuracy: %d%%\n":                "Genauigkeit: %d%%\n",
+		"Accuracy: -":                     "Genauigkeit: -",
+		"Ailment: %s (%d%% chance)\n":     "Statusproblem: %s (%d%% Chance)\n",
+		"Berry":                           "Beere",
+		"Box %d is empty.\n":              "Box %d ist leer.\n",
+		"Box":                             "Box",
+		"Category":                        "Kategorie",
+		"Category: %s\n":                  "Kategorie: %s\n",
+		"Caught":                          "Gefangen",
+		"Chance":                          "Chance",
+		"Choose a number from 1 to %d.\n": "Wähle eine Zahl von 1 bis %d.\n",
+		"Class: %s\n":                     "Klasse: %s\n",
+		"Complete":                        "Vollständig",
+		"Critical hit stage: +%d\n":       "Volltrefferstufe: +%d\n",
+		"Description: %s\n":               "Beschreibung: %s\n",
+		"Drain: heals %d%% of the damage dealt\n": "Absorber: heilt %d%% des verursachten Schadens\n",
+		"Effect: %s\n":                   "Effekt: %s\n",
+		"Encounter rate":                 "Begegnungsrate",
+		"Flinch chance: %d%%\n":          "Zurückschreck-Chance: %d%%\n",
+		"Fling power: %s\n":              "Schleuder-Stärke: %s\n",
+		"Games: %s\n":                    "Spiele: %s\n",
+		"Generation: %s\n":               "Generation: %s\n",
+		"Go, %s! (level %d, %d/%d HP)\n": "Los, %s! (Level %d, %d/%d KP)\n",
+		"Height":                         "Größe",
+		"Immune to":                      "Immun gegen",
+		"In bag":                         "Im Beutel",
+		"In your bag: %d\n":              "In deinem Beutel: %d\n",
+		"It left %s behind as thanks.\n": "Als Dank hat es %s dagelassen.\n",
+		"Item":                           "Item",
+		"Item: %s":                       "Item: %s",
+		"Kind":                           "Art",
+		"Levels":                         "Level",
+		"Location":                       "Ort",
+		"Locations: %d (list them with: locations %s)\n": "Orte: %d (liste sie auf mit: locations %s)\n",
+		"Lv %d":       "Lv. %d",
+		"Lv":          "Lv.",
+		"Match":       "Treffer",
+		"Method":      "Methode",
+		"Money: %s\n": "Geld: %s\n",
+		"Move":        "Attacke",
+		"Move: %s":    "Attacke: %s",
+		"Moves":       "Attacken",
+		"Name":        "Name",
+		"Neither Pokemon could win. The battle is over.": "Keines der Pokémon konnte gewinnen. Der Kampf ist vorbei.",
+		"No Pokemon have this ability.":                  "Kein Pokémon hat diese Fähigkeit.",
+		"No.":                                            "Nr.",
+		"None of your caught Pokemon match.":             "Keines deiner gefangenen Pokémon passt.",
+		"Owned":                                          "Besitz",
+		"PP: %s\n":                                       "AP: %s\n",
+		"Pokedex":                                        "Pokédex",
+		"Pokedexes: %s\n":                                "Pokédexe: %s\n",
+		"Power: %s\n":                                    "Stärke: %s\n",
+		"Price":                                          "Preis",
+		"Price: %s\n":                                    "Preis: %s\n",
+		"Priority: %+d\n":                                "Priorität: %+d\n",
+		"Qty":                                            "Anz.",
+		"Recoil: %d%% of the damage dealt\n":             "Rückstoß: %d%% des verursachten Schadens\n",
+		"Region: %s":                                     "Region: %s",
+		"Resists":                                        "Resistent gegen",
+		"Ripe":                                           "Reif",
+		"Seen":                                           "Gesehen",
+		"Slot":                                           "Platz",
+		"Species":                                        "Art",
+		"Stage":                                          "Stadium",
+		"Target: %s\n":                                   "Ziel: %s\n",
+		"The wild %s fainted! You found %s.\n":           "Das wilde %s wurde besiegt! Du hast %s gefunden.\n",
+		"Total:":                                         "Summe:",
+		"Type: %s\n":                                     "Typ: %s\n",
+		"Types":                                          "Typen",
+		"Version":                                        "Edition",
+		"Weak to":                                        "Schwach gegen",
+		"Weight":                                         "Gewicht",
+		"Welcome to the %s Poke Mart! You have %s.\n":                          "Willkommen im Pokémon-Markt von %s! Du hast %s.\n",
+		"What? %s is evolving! Congratulations! %s evolved into %s!\n":         "Nanu? %s entwickelt sich! Glückwunsch! %s hat sich zu %s entwickelt!\n",
+		"Which move should be forgotten? (1-%d, or press enter to keep them) ": "Welche Attacke soll vergessen werden? (1-%d, oder Enter, um sie zu behalten) ",
+		"You bought %d %s for %s. You have %s left.\n":                         "Du hast %d %s für %s gekauft. Du hast noch %s.\n",
+		"You caught every Pokemon in it. Congratulations!":                     "Du hast alle Pokémon darin gefangen. Glückwunsch!",
+		"You haven't seen any Pokemon from %s yet.\n":                          "Du hast noch keine Pokémon aus %s gesehen.\n",
+		"You picked %d %s!\n":                                       "Du hast %d %s gepflückt!\n",
+		"You planted %s in %s. It will be ripe in %s.\n":            "Du hast %s in %s gepflanzt. Sie ist reif in %s.\n",
+		"You sold %d %s for %s. You have %s now.\n":                 "Du hast %d %s für %s verkauft. Du hast jetzt %s.\n",
+		"Your Pokemon are fighting fit!":                            "Deine Pokémon sind wieder topfit!",
+		"can't read the save file %s: %w":                           "die Speicherdatei %s kann nicht gelesen werden: %w",
+		"can't save the game: %w":                                   "das Spiel kann nicht gespeichert werden: %w",
+		"can't show information on %s. you need to catch one first": "keine Infos zu %s. du musst zuerst eins fangen",
+		"can't show information on %s. you need to catch one first, or look it up with: lookup %s": "keine Infos zu %s. du musst zuerst eins fangen oder es nachschlagen mit: lookup %s",
+		"can't sort by %q. choose one of: %s":                                                      "nach %q kann nicht sortiert werden. wähle eins von: %s",
+		"choose a box from 1 to %d":                                                                "wähle eine Box von 1 bis %d",
+		"flag --%s doesn't take a value":                                                           "die Option --%s nimmt keinen Wert",
+		"flag --%s expects a number, got %q":                                                       "die Option --%s erwartet eine Zahl, erhalten: %q",
+		"flag --%s requires a value":                                                               "die Option --%s braucht einen Wert",
+		"hidden":                                                                                   "versteckt",
+		"in %s":                                                                                    "in %s",
+		"invalid page %q: use a page number, first or last":                                        "ungültige Seite %q: gib eine Seitenzahl, first oder last an",
+		"it won't have any effect on %s":                                                           "es wird bei %s keine Wirkung haben",
+		"it won't have any effect. %s hasn't fainted":                                              "es wird keine Wirkung haben. %s ist nicht besiegt",
+		"it won't have any effect. %s is at full health":                                           "es wird keine Wirkung haben. %s hat volle KP",
+		"it won't have any effect. %s is at the highest level":                                     "es wird keine Wirkung haben. %s hat das höchste Level",
+		"language: %s\n":                                                                           "Sprache: %s\n",
+		"mode: %s\n":                                                                               "Modus: %s\n",
+		"nicknames can be at most %d characters long":                                              "Spitznamen dürfen höchstens %d Zeichen lang sein",
+		"nicknames can't be numbers, they would be mistaken for IDs":                               "Spitznamen dürfen keine Zahlen sein, sie würden mit IDs verwechselt",
+		"no Pokemon can be encountered by %s in %s. try: %s":                                       "mit %s kann in %s kein Pokémon angetroffen werden. versuche: %s",
+		"no moves found for %s with the given filters":                                             "keine Attacken für %s mit diesen Filtern gefunden",
+		"no natures found":                                                                         "keine Wesen gefunden",
+		"none of the berries in %s are ripe yet. check them with the plots command":                "keine der Beeren in %s ist schon reif. sieh sie dir mit dem Befehl plots an",
+		"nothing is planted in %s. plant a berry with the plant command":                           "in %s ist nichts gepflanzt. pflanze eine Beere mit dem Befehl plant",
+		"nothing matches %q":                                                                       "nichts passt zu %q",
+		"now":                                                                                      "jetzt",
+		"output: %s\n":                                                                             "Ausgabe: %s\n",
+		"page %d doesn't exist, there are %d pages":                                                "Seite %d gibt es nicht, es gibt %d Seiten",
+		"page %d of %d (%d areas)":                                                                 "Seite %d von %d (%d Gebiete)",
+		"the %s Poke Mart doesn't sell %s. see what it sells with the shop command": "der Pokémon-Markt von %s verkauft kein %s. sieh dir das Angebot mit dem Befehl shop an",
+		"the limit must be at least 1":                                                     "das Limit muss mindestens 1 sein",
+		"the minimum %s must be a number, got %q":                                          "das Minimum für %s muss eine Zahl sein, erhalten: %q",
+		"the quantity must be a positive number, got %q":                                   "die Menge muss eine positive Zahl sein, erhalten: %q",
+		"there's no Poke Mart in %s. Poke Marts are in towns and cities":                   "in %s gibt es keinen Pokémon-Markt. Pokémon-Märkte gibt es in Städten",
+		"there's no wild %s here. you encountered %s":                                      "hier ist kein wildes %s. du bist %s begegnet",
+		"there's no wild Pokemon to battle. use the encounter command to find one":         "es gibt kein wildes Pokémon zum Kämpfen. finde eins mit dem Befehl encounter",
+		"there's no wild Pokemon to catch. use the encounter command to find one":          "es gibt kein wildes Pokémon zum Fangen. finde eins mit dem Befehl encounter",
+		"throw balls at wild Pokemon with: catch --ball %s":                                "wirf Bälle auf wilde Pokémon mit: catch --ball %s",
+		"trailing backslash in input":                                                      "Backslash am Ende der Eingabe",
+		"translated messages: %s\n":                                                        "übersetzte Meldungen: %s\n",
+		"unknown command %q":                                                               "unbekannter Befehl %q",
+		"unknown flag --%s":                                                                "unbekannte Option --%s",
+		"unknown kind %q (choose from pokemon, location)":                                  "unbekannte Art %q (wähle pokemon oder location)",
+		"unknown mode %q. choose game or reference":                                        "unbekannter Modus %q. wähle game oder reference",
+		"unknown setting %q":                                                               "unbekannte Einstellung %q",
+		"unterminated double quote in input":                                               "nicht geschlossenes doppeltes Anführungszeichen in der Eingabe",
+		"unterminated single quote in input":                                               "nicht geschlossenes einfaches Anführungszeichen in der Eingabe",
+		"usage: %s <item> [quantity]":                                                      "Verwendung: %s <item> [quantity]",
+		"usage: battle [pokemon]":                                                          "Verwendung: battle [pokemon]",
+		"usage: catch [pokemon_name] [--ball <ball>]":                                      "Verwendung: catch [pokemon_name] [--ball <ball>]",
+		"usage: compare <pokemon> <pokemon> [pokemon...] [--any]":                          "Verwendung: compare <pokemon> <pokemon> [pokemon...] [--any]",
+		"usage: deposit <pokemon> [box]":                                                   "Verwendung: deposit <pokemon> [box]",
+		"usage: encounter [walk|surf|fish <old-rod|good-rod|super-rod>]":                   "Verwendung: encounter [walk|surf|fish <old-rod|good-rod|super-rod>]",
+		"usage: language [<code>]":                                                         "Verwendung: language [<code>]",
+		"usage: map [page|first|last] [--limit N]":                                         "Verwendung: map [page|first|last] [--limit N]",
+		"usage: moves <pokemon> [--method level-up|machine|egg|tutor] [--version-group X]": "Verwendung: moves <pokemon> [--method level-up|machine|egg|tutor] [--version-group X]",
+		"usage: nickname <pokemon> <nickname> (use \"\" to remove a nickname)":             "Verwendung: nickname <pokemon> <nickname> (use \"\" to remove a nickname)",
+		"usage: plant <berry>":                                                             "Verwendung: plant <berry>",
+		"usage: pokedex [--region <region>] [--missing] [--type <type>] [--min-stat <stat=value>] [--sort <order>] [--desc] [--limit N]": "Verwendung: pokedex [--region <region>] [--missing] [--type <type>] [--min-stat <stat=value>] [--sort <order>] [--desc] [--limit N]",
+		"usage: release <pokemon>":     "Verwendung: release <pokemon>",
+		"usage: set <setting> <value>": "Verwendung: set <setting> <value>",
+		"usage: use <item> <pokemon>":  "Verwendung: use <item> <pokemon>",
+		"usage: version [<game>|all]":  "Verwendung: version [<game>|all]",
+		"usage: withdraw <pokemon>":    "Verwendung: withdraw <pokemon>",
+		"version: %s\n":                "Edition: %s\n",
+		"warning: can't load %d of your caught Pokemon, they'll be loaded next time: %s\n": "Warnung: %d deiner gefangenen Pokémon können nicht geladen werden, sie werden beim nächsten Mal geladen: %s\n",
+		"you aren't anywhere yet. explore a location first":                                "du bist noch nirgends. erkunde zuerst einen Ort",
+		"you can trade at most %d items at once":                                           "du kannst höchstens %d Items auf einmal handeln",
+		"you don't have a Pokemon called %s":                                               "du hast kein Pokémon namens %s",
+		"you don't have a Pokemon with ID %d":                                              "du hast kein Pokémon mit der ID %d",
+		"you don't have any %s to plant":                                                   "du hast keine %s zum Pflanzen",
+		"you don't have any %s":                                                            "du hast kein %s",
+		"you don't have any %s. check your bag with the bag command":                       "du hast kein %s. sieh mit dem Befehl bag in deinen Beutel",
+		"you have %d Pokemon called %s. use one of their IDs: %s":                          "du hast %d Pokémon namens %s. nutze eine ihrer IDs: %s",
+		"you have no Pokemon that can battle":                                              "du hast kein Pokémon, das kämpfen kann",
+		"you haven't caught %s. compare any Pokemon with --any":                            "du hast %s nicht gefangen. vergleiche beliebige Pokémon mit --any",
+		"you haven't planted any berries":                                                  "du hast keine Beeren gepflanzt",
+		"you must explore an area for Pokemon encounters first":                            "du musst zuerst ein Gebiet nach Pokémon erkunden",
+		"you must provide a location name":                                                 "du musst einen Ortsnamen angeben",
+		"you must provide a move name":                                                     "du musst den Namen einer Attacke angeben",
+		"you must provide a pokemon name":                                                  "du musst einen Pokémon-Namen angeben",
+		"you must provide a region name":                                                   "du musst einen Regionsnamen angeben",
+		"you must provide a search term":                                                   "du musst einen Suchbegriff angeben",
+		"you must provide an ability name":                                                 "du musst den Namen einer Fähigkeit angeben",
+		"you must provide an item name":                                                    "du musst einen Itemnamen angeben",
+		"you only have %d %s":                                                              "du hast nur %d %s",
+		"you're on the first page":                                                         "du bist auf der ersten Seite",
+		"you're on the last page":                                                          "du bist auf der letzten Seite",
+		"your bag is empty. you have %s":                                                   "dein Beutel ist leer. du hast %s",
+		"your party is empty. go catch some pokemon":                                       "dein Team ist leer. fang ein paar Pokémon",
+		"your pokedex is empty. go explore and catch some pokemon":                         "dein Pokédex ist leer. geh erkunden und fang ein paar Pokémon",
+
+		"Displays a help message": "Zeigt eine Hilfemeldung an",
+		"Exit the Pokedex":        "Beendet den Pokédex",
+		"Displays the next page of location-areas, or jumps to the given page":               "Zeigt die nächste Seite der Gebiete an oder springt zur angegebenen Seite",
+		"Displays the previous page of location-areas":                                       "Zeigt die vorherige Seite der Gebiete an",
+		"Displays all Pokemon in the area given, or in every area of a location":             "Zeigt alle Pokémon im angegebenen Gebiet oder in allen Gebieten eines Ortes an",
+		"Lists the location areas where a Pokemon can be found":                              "Listet die Gebiete auf, in denen ein Pokémon zu finden ist",
+		"Limits encounters, moves, types and descriptions to one game, e.g. version diamond": "Beschränkt Begegnungen, Attacken, Typen und Beschreibungen auf ein Spiel, z. B. version diamond",
+		"Shows names and messages in a language, e.g. language es":                           "Zeigt Namen und Meldungen in einer Sprache an, z. B. language es",
+		"Lists all regions":                             "Listet alle Regionen auf",
+		"Shows details about a region":                  "Zeigt Details zu einer Region an",
+		"Lists the locations in a region":               "Listet die Orte einer Region auf",
+		"Looks for a wild Pokemon in the explored area": "Sucht im erkundeten Gebiet nach einem wilden Pokémon",
+		"Throws a Poke Ball, or the ball given, at the wild Pokemon you encountered":      "Wirft einen Pokéball oder den angegebenen Ball auf das wilde Pokémon, dem du begegnet bist",
+		"Shows details about a caught Pokemon, or any Pokemon with --any":                 "Zeigt Details zu einem gefangenen Pokémon an, oder zu jedem Pokémon mit --any",
+		"Shows details about any Pokemon, caught or not":                                  "Zeigt Details zu jedem Pokémon an, ob gefangen oder nicht",
+		"Lists the Pokemon you have seen and caught, and how complete your pokedex is":    "Listet die gesehenen und gefangenen Pokémon auf und wie vollständig dein Pokédex ist",
+		"Shows Pokemon side by side: stats, types, abilities, size and type matchups":     "Zeigt Pokémon nebeneinander: Werte, Typen, Fähigkeiten, Größe und Typ-Effektivität",
+		"Shows a move's type, power, accuracy, PP and effect":                             "Zeigt Typ, Stärke, Genauigkeit, AP und Effekt einer Attacke an",
+		"Lists the moves a Pokemon can learn":                                             "Listet die Attacken auf, die ein Pokémon erlernen kann",
+		"Shows what an ability does and which Pokemon can have it":                        "Zeigt, was eine Fähigkeit bewirkt und welche Pokémon sie haben können",
+		"Finds Pokemon and location-areas with names similar to the term":                 "Findet Pokémon und Gebiete mit ähnlichen Namen wie der Suchbegriff",
+		"Displays the Pokemon in your party":                                              "Zeigt die Pokémon in deinem Team an",
+		"Displays the Pokemon in a PC box, or how full the boxes are":                     "Zeigt die Pokémon in einer PC-Box an oder wie voll die Boxen sind",
+		"Moves a Pokemon from your party into a PC box":                                   "Legt ein Pokémon aus deinem Team in eine PC-Box",
+		"Moves a Pokemon from a PC box into your party":                                   "Nimmt ein Pokémon aus einer PC-Box in dein Team",
+		"Gives one of your Pokemon a nickname":                                            "Gibt einem deiner Pokémon einen Spitznamen",
+		"Releases one of your Pokemon back into the wild":                                 "Lässt eines deiner Pokémon wieder frei",
+		"Fights the wild Pokemon you encountered with your lead Pokemon or the one given": "Kämpft mit deinem ersten oder dem angegebenen Pokémon gegen das wilde Pokémon, dem du begegnet bist",
+		"Restores the HP of the Pokemon in your party":                                    "Stellt die KP der Pokémon in deinem Team wieder her",
+		"Displays the items in your bag":                                                  "Zeigt die Items in deinem Beutel an",
+		"Shows an item's category, price and effect":                                      "Zeigt Kategorie, Preis und Effekt eines Items an",
+		"Uses an item from your bag on one of your Pokemon":                               "Setzt ein Item aus deinem Beutel bei einem deiner Pokémon ein",
+		"Lists the items sold at the Poke Mart of the explored location":                  "Listet die Items auf, die im Pokémon-Supermarkt des erkundeten Ortes verkauft werden",
+		"Buys items at the Poke Mart":                                                     "Kauft Items im Pokémon-Supermarkt",
+		"Sells items from your bag at the Poke Mart for half their price":                 "Verkauft Items aus deinem Beutel im Pokémon-Supermarkt zum halben Preis",
+		"Plants a berry from your bag in a soil plot at the explored location":            "Pflanzt eine Beere aus deinem Beutel in ein Beet am erkundeten Ort",
+		"Picks the ripe berries at the explored location":                                 "Pflückt die reifen Beeren am erkundeten Ort",
+		"Displays the berries you planted and when they will be ripe":                     "Zeigt die gepflanzten Beeren an und wann sie reif sind",
+		"Changes a setting: output (table, json, yaml, csv), mode (game, reference), version (a game or all) or language (a language code), e.g. set output json": "Ändert eine Einstellung: output (table, json, yaml, csv), mode (game, reference), version (ein Spiel oder all) oder language (ein Sprachcode), z. B. set output json",
+		"ability":       "Fähigkeit",
+		"berry":         "Beere",
+		"item":          "Item",
+		"language":      "Sprache",
+		"location area": "Gebiet",
+		"move":          "Attacke",
+		"pokemon":       "Pokémon",
+		"region":        "Region",
+		"version":       "Edition",
+		"wild %s":       "wildes %s",
+		"party":         "Team",
+		"box %d":        "Box %d",
+	},
+	"es": {
+		"Welcome to the Pokedex!":                                 "¡Bienvenido a la Pokédex!",
+		"Usage:":                                                  "Uso:",
+		"Closing the Pokedex... Goodbye!":                         "Cerrando la Pokédex... ¡Adiós!",
+		"Exploring %s...\n":                                       "Explorando %s...\n",
+		"Found Pokemon: ":                                         "Pokémon encontrados: ",
+		"No Pokemon found.":                                       "No se encontraron Pokémon.",
+		"%s can be found in:\n":                                   "%s se puede encontrar en:\n",
+		"A wild %s (level %d) appeared in %s!\n":                  "¡Un %s salvaje (nivel %d) apareció en %s!\n",
+		"Throw a Pokeball at it with the catch command.":          "Lánzale una Poké Ball con el comando catch.",
+		"Throwing a %s at %s...\n":                                "Lanzando una %s a %s...\n",
+		"%s (level %d) was caught!\n":                             "¡Has atrapado a %s (nivel %d)!\n",
+		"Your party is full, so %s (ID %d) was sent to box %d.\n": "Tu equipo está completo, así que %s (ID %d) fue enviado a la caja %d.\n",
+		"%s (ID %d) joined your party.\n":                         "%s (ID %d) se unió a tu equipo.\n",
+		"You may now inspect it with the inspect command.":        "Ahora puedes examinarlo con el comando inspect.",
+		"%s escaped!\n":                                           "¡%s escapó!\n",
+		"Name: %s":                                                "Nombre: %s",
+		"ID: %d\nLevel: %d (%d XP)\n":                             "ID: %d\nNivel: %d (%d EXP)\n",
+		"Nature: %s\n":                                            "Naturaleza: %s\n",
+		"Nature: %s (+%s, -%s)\n":                                 "Naturaleza: %s (+%s, -%s)\n",
+		"Height: %d\nWeight: %d\n":                                "Altura: %d\nPeso: %d\n",
+		"Types: %s\n":                                             "Tipos: %s\n",
+		"Abilities: %s\n":                                         "Habilidades: %s\n",
+		"%s (hidden)":                                             "%s (oculta)",
+		"Showing data from %s.\n":                                 "Mostrando datos de %s.\n",
+		"Showing data from every game.":                           "Mostrando datos de todos los juegos.",
+		"Showing names and messages in %s.\n":                     "Mostrando nombres y mensajes en %s.\n",
+		"Pokemon":                                                 "Pokémon",
+		"Area":                                                    "Zona",
+		"Stat":                                                    "Estadística",
+		"Base":                                                    "Base",
+		"%d %s cost %s, but you only have %s":                     "%d %s cuestan %s, pero solo tienes %s",
+		"%d locations in %s":                                      "%d lugares en %s",
+		"%s %d base, %d at level %d\n":                            "%s %d base, %d al nivel %d\n",
+		"%s %q not found":                                         "no se encontró %s %q",
+		"%s %q not found. did you mean %s?":                       "no se encontró %s %q. ¿quisiste decir %s?",
+		"%s attacks %s for %d damage. (%d/%d HP left)\n":          "%s ataca a %s y causa %d de daño. (quedan %d/%d PS)\n",
+		"%s can't be found in the wild":                           "%s no se puede encontrar en estado salvaje",
+		"%s can't be found that way. try without --version and --method": "%s no se puede encontrar así. prueba sin --version ni --method",
+		"%s can't be sold":                                                      "%s no se puede vender",
+		"%s can't be used on a Pokemon":                                         "%s no se puede usar en un Pokémon",
+		"%s did not learn %s.\n":                                                "%s no aprendió %s.\n",
+		"%s fainted! The wild %s is still here.\n":                              "¡%s se debilitó! El %s salvaje sigue aquí.\n",
+		"%s gained %d XP.\n":                                                    "%s ganó %d puntos de experiencia.\n",
+		"%s grew to level %d!\n":                                                "¡%s subió al nivel %d!\n",
+		"%s has fainted and can't battle":                                       "%s está debilitado y no puede combatir",
+		"%s has fainted. use a revive first":                                    "%s está debilitado. usa primero un revivir",
+		"%s has no areas to explore":                                            "%s no tiene zonas que explorar",
+		"%s has no pokedex":                                                     "%s no tiene Pokédex",
+		"%s is in box %d. withdraw it to battle":                                "%s está en la caja %d. sácalo para combatir",
+		"%s is not a Poke Ball":                                                 "%s no es una Poké Ball",
+		"%s is now called %s.\n":                                                "%s ahora se llama %s.\n",
+		"%s joined your party.\n":                                               "%s se unió a tu equipo.\n",
+		"%s learned %s!\n":                                                      "¡%s aprendió %s!\n",
+		"%s recovered %d HP. (%d/%d HP)\n":                                      "%s recuperó %d PS. (%d/%d PS)\n",
+		"%s wants to learn %s, but it already knows %d moves:\n":                "%s quiere aprender %s, pero ya conoce %d movimientos:\n",
+		"%s was deposited in box %d.\n":                                         "%s fue depositado en la caja %d.\n",
+		"%s was released. Bye, %s!\n":                                           "%s fue liberado. ¡Adiós, %s!\n",
+		"%s was revived! (%d/%d HP)\n":                                          "¡%s fue reanimado! (%d/%d PS)\n",
+		"%s's nickname was removed.\n":                                          "Se quitó el mote de %s.\n",
+		"%w. look up any Pokemon with: lookup %s":                               "%w. consulta cualquier Pokémon con: lookup %s",
+		"--min-stat takes stat=value pairs, like speed=100. stats are: %s, bst": "--min-stat recibe pares estadística=valor, como speed=100. las estadísticas son: %s, bst",
+		"--missing lists Pokemon you haven't caught, so it can't be filtered or sorted": "--missing lista los Pokémon que no has atrapado, así que no se puede filtrar ni ordenar",
+		"1, 2, and... Poof! %s forgot %s and learned %s!\n":                             "1, 2 y... ¡Puf! ¡%s olvidó %s y aprendió %s!\n",
+		"Abilities":                       "Habilidades",
+		"Ability: %s":                     "Habilidad: %s",
+		"Accuracy: %d%%\n":                "Precisión: %d%%\n",
+		"Accuracy: -":                     "Precisión: -",
+		"Ailment: %s (%d%% chance)\n":     "Problema de estado: %s (%d%% de probabilidad)\n",
+		"Berry":                           "Baya",
+		"Box %d is empty.\n":              "La caja %d está vacía.\n",
+		"Box":                             "Caja",
+		"Category":                        "Categoría",
+		"Category: %s\n":                  "Categoría: %s\n",
+		"Caught":                          "Atrapados",
+		"Chance":                          "Probabilidad",
+		"Choose a number from 1 to %d.\n": "Elige un número del 1 al %d.\n",
+		"Class: %s\n":                     "Clase: %s\n",
+		"Complete":                        "Completado",
+		"Critical hit stage: +%d\n":       "Índice de golpe crítico: +%d\n",
+		"Description: %s\n":               "Descripción: %s\n",
+		"Drain: heals %d%% of the damage dealt\n": "Drenaje: cura el %d%% del daño causado\n",
+		"Effect: %s\n":                   "Efecto: %s\n",
+		"Encounter rate":                 "Tasa de encuentro",
+		"Flinch chance: %d%%\n":          "Probabilidad de retroceso: %d%%\n",
+		"Fling power: %s\n":              "Potencia de Lanzamiento: %s\n",
+		"Games: %s\n":                    "Juegos: %s\n",
+		"Generation: %s\n":               "Generación: %s\n",
+		"Go, %s! (level %d, %d/%d HP)\n": "¡Adelante, %s! (nivel %d, %d/%d PS)\n",
+		"Height":                         "Altura",
+		"Immune to":                      "Inmune a",
+		"In bag":                         "En la mochila",
+		"In your bag: %d\n":              "En tu mochila: %d\n",
+		"It left %s behind as thanks.\n": "Dejó %s como agradecimiento.\n",
+		"Item":                           "Objeto",
+		"Item: %s":                       "Objeto: %s",
+		"Kind":                           "Tipo",
+		"Levels":                         "Niveles",
+		"Location":                       "Lugar",
+		"Locations: %d (list them with: locations %s)\n": "Lugares: %d (lístalos con: locations %s)\n",
+		"Lv %d":       "Nv. %d",
+		"Lv":          "Nv.",
+		"Match":       "Coincidencia",
+		"Method":      "Método",
+		"Money: %s\n": "Dinero: %s\n",
+		"Move":        "Movimiento",
+		"Move: %s":    "Movimiento: %s",
+		"Moves":       "Movimientos",
+		"Name":        "Nombre",
+		"Neither Pokemon could win. The battle is over.": "Ningún Pokémon pudo ganar. El combate ha terminado.",
+		"No Pokemon have this ability.":                  "Ningún Pokémon tiene esta habilidad.",
+		"No.":                                            "N.º",
+		"None of your caught Pokemon match.":             "Ninguno de tus Pokémon atrapados coincide.",
+		"Owned":                                          "Tienes",
+		"PP: %s\n":                                       "PP: %s\n",
+		"Pokedex":                                        "Pokédex",
+		"Pokedexes: %s\n":                                "Pokédex: %s\n",
+		"Power: %s\n":                                    "Potencia: %s\n",
+		"Price":                                          "Precio",
+		"Price: %s\n":                                    "Precio: %s\n",
+		"Priority: %+d\n":                                "Prioridad: %+d\n",
+		"Qty":                                            "Cant.",
+		"Recoil: %d%% of the damage dealt\n":             "Retroceso: %d%% del daño causado\n",
+		"Region: %s":                                     "Región: %s",
+		"Resists":                                        "Resiste",
+		"Ripe":                                           "Madura",
+		"Seen":                                           "Vistos",
+		"Slot":                                           "Puesto",
+		"Species":                                        "Especie",
+		"Stage":                                          "Fase",
+		"Target: %s\n":                                   "Objetivo: %s\n",
+		"The wild %s fainted! You found %s.\n":           "¡El %s salvaje se debilitó! Encontraste %s.\n",
+		"Total:":                                         "Total:",
+		"Type: %s\n":                                     "Tipo: %s\n",
+		"Types":                                          "Tipos",
+		"Version":                                        "Versión",
+		"Weak to":                                        "Débil a",
+		"Weight":                                         "Peso",
+		"Welcome to the %s Poke Mart! You have %s.\n":                          "¡Bienvenido a la Tienda Pokémon de %s! Tienes %s.\n",
+		"What? %s is evolving! Congratulations! %s evolved into %s!\n":         "¿Qué? ¡%s está evolucionando! ¡Enhorabuena! ¡%s evolucionó a %s!\n",
+		"Which move should be forgotten? (1-%d, or press enter to keep them) ": "¿Qué movimiento debe olvidar? (1-%d, o pulsa Intro para conservarlos) ",
+		"You bought %d %s for %s. You have %s left.\n":                         "Compraste %d %s por %s. Te quedan %s.\n",
+		"You caught every Pokemon in it. Congratulations!":                     "Has atrapado todos sus Pokémon. ¡Enhorabuena!",
+		"You haven't seen any Pokemon from %s yet.\n":                          "Aún no has visto ningún Pokémon de %s.\n",
+		"You picked %d %s!\n":                                       "¡Recogiste %d %s!\n",
+		"You planted %s in %s. It will be ripe in %s.\n":            "Plantaste %s en %s. Estará madura en %s.\n",
+		"You sold %d %s for %s. You have %s now.\n":                 "Vendiste %d %s por %s. Ahora tienes %s.\n",
+		"Your Pokemon are fighting fit!":                            "¡Tus Pokémon están en plena forma!",
+		"can't read the save file %s: %w":                           "no se puede leer el archivo de guardado %s: %w",
+		"can't save the game: %w":                                   "no se puede guardar la partida: %w",
+		"can't show information on %s. you need to catch one first": "no se puede mostrar información de %s. primero tienes que atrapar uno",
+		"can't show information on %s. you need to catch one first, or look it up with: lookup %s": "no se puede mostrar información de %s. primero tienes que atrapar uno, o consúltalo con: lookup %s",
+		"can't sort by %q. choose one of: %s":                                                      "no se puede ordenar por %q. elige uno de: %s",
+		"choose a box from 1 to %d":                                                                "elige una caja del 1 al %d",
+		"flag --%s doesn't take a value":                                                           "la opción --%s no admite un valor",
+		"flag --%s expects a number, got %q":                                                       "la opción --%s espera un número, se recibió %q",
+		"flag --%s requires a value":                                                               "la opción --%s necesita un valor",
+		"hidden":                                                                                   "oculta",
+		"in %s":                                                                                    "en %s",
+		"invalid page %q: use a page number, first or last":                                        "página no válida %q: usa un número de página, first o last",
+		"it won't have any effect on %s":                                                           "no tendrá ningún efecto en %s",
+		"it won't have any effect. %s hasn't fainted":                                              "no tendrá ningún efecto. %s no está debilitado",
+		"it won't have any effect. %s is at full health":                                           "no tendrá ningún efecto. %s tiene todos sus PS",
+		"it won't have any effect. %s is at the highest level":                                     "no tendrá ningún efecto. %s está al nivel máximo",
+		"language: %s\n":                                                                           "idioma: %s\n",
+		"mode: %s\n":                                                                               "modo: %s\n",
+		"nicknames can be at most %d characters long":                                              "los motes pueden tener como máximo %d caracteres",
+		"nicknames can't be numbers, they would be mistaken for IDs":                               "los motes no pueden ser números, se confundirían con IDs",
+		"no Pokemon can be encountered by %s in %s. try: %s":                                       "no se puede encontrar ningún Pokémon con %s en %s. prueba: %s",
+		"no moves found for %s with the given filters":                                             "no se encontraron movimientos de %s con esos filtros",
+		"no natures found":                                                                         "no se encontraron naturalezas",
+		"none of the berries in %s are ripe yet. check them with the plots command":                "ninguna de las bayas de %s está madura aún. revísalas con el comando plots",
+		"nothing is planted in %s. plant a berry with the plant command":                           "no hay nada plantado en %s. planta una baya con el comando plant",
+		"nothing matches %q":                                                                       "nada coincide con %q",
+		"now":                                                                                      "ya",
+		"output: %s\n":                                                                             "salida: %s\n",
+		"page %d doesn't exist, there are %d pages":                                                "la página %d no existe, hay %d páginas",
+		"page %d of %d (%d areas)":                                                                 "página %d de %d (%d zonas)",
+		"the %s Poke Mart doesn't sell %s. see what it sells with the shop command": "la Tienda Pokémon de %s no vende %s. mira lo que vende con el comando shop",
+		"the limit must be at least 1":                                                     "el límite debe ser al menos 1",
+		"the minimum %s must be a number, got %q":                                          "el mínimo de %s debe ser un número, se recibió %q",
+		"the quantity must be a positive number, got %q":                                   "la cantidad debe ser un número positivo, se recibió %q",
+		"there's no Poke Mart in %s. Poke Marts are in towns and cities":                   "no hay Tienda Pokémon en %s. las Tiendas Pokémon están en pueblos y ciudades",
+		"there's no wild %s here. you encountered %s":                                      "aquí no hay ningún %s salvaje. te encontraste con %s",
+		"there's no wild Pokemon to battle. use the encounter command to find one":         "no hay ningún Pokémon salvaje con el que combatir. usa el comando encounter para encontrar uno",
+		"there's no wild Pokemon to catch. use the encounter command to find one":          "no hay ningún Pokémon salvaje que atrapar. usa el comando encounter para encontrar uno",
+		"throw balls at wild Pokemon with: catch --ball %s":                                "lanza Balls a Pokémon salvajes con: catch --ball %s",
+		"trailing backslash in input":                                                      "barra invertida al final de la entrada",
+		"translated messages: %s\n":                                                        "mensajes traducidos: %s\n",
+		"unknown command %q":                                                               "comando desconocido %q",
+		"unknown flag --%s":                                                                "opción desconocida --%s",
+		"unknown kind %q (choose from pokemon, location)":                                  "tipo desconocido %q (elige entre pokemon, location)",
+		"unknown mode %q. choose game or reference":                                        "modo desconocido %q. elige game o reference",
+		"unknown setting %q":                                                               "ajuste desconocido %q",
+		"unterminated double quote in input":                                               "comillas dobles sin cerrar en la entrada",
+		"unterminated single quote in input":                                               "comilla simple sin cerrar en la entrada",
+		"usage: %s <item> [quantity]":                                                      "uso: %s <item> [quantity]",
+		"usage: battle [pokemon]":                                                          "uso: battle [pokemon]",
+		"usage: catch [pokemon_name] [--ball <ball>]":                                      "uso: catch [pokemon_name] [--ball <ball>]",
+		"usage: compare <pokemon> <pokemon> [pokemon...] [--any]":                          "uso: compare <pokemon> <pokemon> [pokemon...] [--any]",
+		"usage: deposit <pokemon> [box]":                                                   "uso: deposit <pokemon> [box]",
+		"usage: encounter [walk|surf|fish <old-rod|good-rod|super-rod>]":                   "uso: encounter [walk|surf|fish <old-rod|good-rod|super-rod>]",
+		"usage: language [<code>]":                                                         "uso: language [<code>]",
+		"usage: map [page|first|last] [--limit N]":                                         "uso: map [page|first|last] [--limit N]",
+		"usage: moves <pokemon> [--method level-up|machine|egg|tutor] [--version-group X]": "uso: moves <pokemon> [--method level-up|machine|egg|tutor] [--version-group X]",
+		"usage: nickname <pokemon> <nickname> (use \"\" to remove a nickname)":             "uso: nickname <pokemon> <nickname> (use \"\" to remove a nickname)",
+		"usage: plant <berry>":                                                             "uso: plant <berry>",
+		"usage: pokedex [--region <region>] [--missing] [--type <type>] [--min-stat <stat=value>] [--sort <order>] [--desc] [--limit N]": "uso: pokedex [--region <region>] [--missing] [--type <type>] [--min-stat <stat=value>] [--sort <order>] [--desc] [--limit N]",
+		"usage: release <pokemon>":     "uso: release <pokemon>",
+		"usage: set <setting> <value>": "uso: set <setting> <value>",
+		"usage: use <item> <pokemon>":  "uso: use <item> <pokemon>",
+		"usage: version [<game>|all]":  "uso: version [<game>|all]",
+		"usage: withdraw <pokemon>":    "uso: withdraw <pokemon>",
+		"version: %s\n":                "versión: %s\n",
+		"warning: can't load %d of your caught Pokemon, they'll be loaded next time: %s\n": "aviso: no se pueden cargar %d de tus Pokémon atrapados, se cargarán la próxima vez: %s\n",
+		"you aren't anywhere yet. explore a location first":                                "todavía no estás en ningún sitio. explora primero un lugar",
+		"you can trade at most %d items at once":                                           "puedes comerciar como máximo %d objetos a la vez",
+		"you don't have a Pokemon called %s":                                               "no tienes ningún Pokémon llamado %s",
+		"you don't have a Pokemon with ID %d":                                              "no tienes ningún Pokémon con el ID %d",
+		"you don't have any %s to plant":                                                   "no tienes ninguna %s que plantar",
+		"you don't have any %s":                                                            "no tienes ningún %s",
+		"you don't have any %s. check your bag with the bag command":                       "no tienes ningún %s. revisa tu mochila con el comando bag",
+		"you have %d Pokemon called %s. use one of their IDs: %s":                          "tienes %d Pokémon llamados %s. usa uno de sus IDs: %s",
+		"you have no Pokemon that can battle":                                              "no tienes ningún Pokémon que pueda combatir",
+		"you haven't caught %s. compare any Pokemon with --any":                            "no has atrapado a %s. compara cualquier Pokémon con --any",
+		"you haven't planted any berries":                                                  "no has plantado ninguna baya",
+		"you must explore an area for Pokemon encounters first":                            "primero tienes que explorar una zona en busca de Pokémon",
+		"you must provide a location name":                                                 "tienes que indicar el nombre de un lugar",
+		"you must provide a move name":                                                     "tienes que indicar el nombre de un movimiento",
+		"you must provide a pokemon name":                                                  "tienes que indicar el nombre de un Pokémon",
+		"you must provide a region name":                                                   "tienes que indicar el nombre de una región",
+		"you must provide a search term":                                                   "tienes que indicar un término de búsqueda",
+		"you must provide an ability name":                                                 "tienes que indicar el nombre de una habilidad",
+		"you must provide an item name":                                                    "tienes que indicar el nombre de un objeto",
+		"you only have %d %s":                                                              "solo tienes %d %s",
+		"you're on the first page":                                                         "estás en la primera página",
+		"you're on the last page":                                                          "estás en la última página",
+		"your bag is empty. you have %s":                                                   "tu mochila está vacía. tienes %s",
+		"your party is empty. go catch some pokemon":                                       "tu equipo está vacío. ve a atrapar algunos Pokémon",
+		"your pokedex is empty. go explore and catch some pokemon":                         "tu Pokédex está vacía. ve a explorar y atrapa algunos Pokémon",
+
+		"Displays a help message": "Muestra un mensaje de ayuda",
+		"Exit the Pokedex":        "Cierra la Pokédex",
+		"Displays the next page of location-areas, or jumps to the given page":               "Muestra la siguiente página de zonas, o salta a la página indicada",
+		"Displays the previous page of location-areas":                                       "Muestra la página anterior de zonas",
+		"Displays all Pokemon in the area given, or in every area of a location":             "Muestra todos los Pokémon de la zona indicada, o de todas las zonas de un lugar",
+		"Lists the location areas where a Pokemon can be found":                              "Enumera las zonas donde se puede encontrar un Pokémon",
+		"Limits encounters, moves, types and descriptions to one game, e.g. version diamond": "Limita los encuentros, movimientos, tipos y descripciones a un juego, p. ej. version diamond",
+		"Shows names and messages in a language, e.g. language es":                           "Muestra los nombres y mensajes en un idioma, p. ej. language es",
+		"Lists all regions":                             "Enumera todas las regiones",
+		"Shows details about a region":                  "Muestra detalles de una región",
+		"Lists the locations in a region":               "Enumera los lugares de una región",
+		"Looks for a wild Pokemon in the explored area": "Busca un Pokémon salvaje en la zona explorada",
+		"Throws a Poke Ball, or the ball given, at the wild Pokemon you encountered":      "Lanza una Poké Ball, o la ball indicada, al Pokémon salvaje que encontraste",
+		"Shows details about a caught Pokemon, or any Pokemon with --any":                 "Muestra detalles de un Pokémon capturado, o de cualquier Pokémon con --any",
+		"Shows details about any Pokemon, caught or not":                                  "Muestra detalles de cualquier Pokémon, capturado o no",
+		"Lists the Pokemon you have seen and caught, and how complete your pokedex is":    "Enumera los Pokémon que has visto y capturado, y cuánto has completado la Pokédex",
+		"Shows Pokemon side by side: stats, types, abilities, size and type matchups":     "Muestra Pokémon lado a lado: estadísticas, tipos, habilidades, tamaño y eficacia de tipos",
+		"Shows a move's type, power, accuracy, PP and effect":                             "Muestra el tipo, potencia, precisión, PP y efecto de un movimiento",
+		"Lists the moves a Pokemon can learn":                                             "Enumera los movimientos que puede aprender un Pokémon",
+		"Shows what an ability does and which Pokemon can have it":                        "Muestra qué hace una habilidad y qué Pokémon pueden tenerla",
+		"Finds Pokemon and location-areas with names similar to the term":                 "Busca Pokémon y zonas con nombres parecidos al término",
+		"Displays the Pokemon in your party":                                              "Muestra los Pokémon de tu equipo",
+		"Displays the Pokemon in a PC box, or how full the boxes are":                     "Muestra los Pokémon de una caja del PC, o cuánto están llenas las cajas",
+		"Moves a Pokemon from your party into a PC box":                                   "Deja un Pokémon de tu equipo en una caja del PC",
+		"Moves a Pokemon from a PC box into your party":                                   "Saca un Pokémon de una caja del PC a tu equipo",
+		"Gives one of your Pokemon a nickname":                                            "Pone un mote a uno de tus Pokémon",
+		"Releases one of your Pokemon back into the wild":                                 "Libera a uno de tus Pokémon en la naturaleza",
+		"Fights the wild Pokemon you encountered with your lead Pokemon or the one given": "Combate contra el Pokémon salvaje que encontraste con tu primer Pokémon o el indicado",
+		"Restores the HP of the Pokemon in your party":                                    "Restaura los PS de los Pokémon de tu equipo",
+		"Displays the items in your bag":                                                  "Muestra los objetos de tu mochila",
+		"Shows an item's category, price and effect":                                      "Muestra la categoría, precio y efecto de un objeto",
+		"Uses an item from your bag on one of your Pokemon":                               "Usa un objeto de tu mochila en uno de tus Pokémon",
+		"Lists the items sold at the Poke Mart of the explored location":                  "Enumera los objetos que vende la Tienda Pokémon del lugar explorado",
+		"Buys items at the Poke Mart":                                                     "Compra objetos en la Tienda Pokémon",
+		"Sells items from your bag at the Poke Mart for half their price":                 "Vende objetos de tu mochila en la Tienda Pokémon por la mitad de su precio",
+		"Plants a berry from your bag in a soil plot at the explored location":            "Planta una baya de tu mochila en un terreno del lugar explorado",
+		"Picks the ripe berries at the explored location":                                 "Recoge las bayas maduras del lugar explorado",
+		"Displays the berries you planted and when they will be ripe":                     "Muestra las bayas que plantaste y cuándo estarán maduras",
+		"Changes a setting: output (table, json, yaml, csv), mode (game, reference), version (a game or all) or language (a language code), e.g. set output json": "Cambia un ajuste: output (table, json, yaml, csv), mode (game, reference), version (un juego o all) o language (un código de idioma), p. ej. set output json",
+		"ability":       "habilidad",
+		"berry":         "baya",
+		"item":          "objeto",
+		"language":      "idioma",
+		"location area": "zona",
+		"move":          "movimiento",
+		"pokemon":       "pokémon",
+		"region":        "región",
+		"version":       "versión",
+		"wild %s":       "%s salvaje",
+		"party":         "equipo",
+		"box %d":        "caja %d",
+	},
+	"fr": {
+		"Welcome to the Pokedex!":                                 "Bienvenue dans le Pokédex !",
+		"Usage:":                                                  "Utilisation :",
+		"Closing the Pokedex... Goodbye!":                         "Fermeture du Pokédex... Au revoir !",
+		"Exploring %s...\n":                                       "Exploration de %s...\n",
+		"Found Pokemon: ":                                         "Pokémon trouvés : ",
+		"No Pokemon found.":                                       "Aucun Pokémon trouvé.",
+		"%s can be found in:\n":                                   "%s se trouve dans :\n",
+		"A wild %s (level %d) appeared in %s!\n":                  "Un %s sauvage (niveau %d) apparaît dans %s !\n",
+		"Throw a Pokeball at it with the catch command.":          "Lancez-lui une Poké Ball avec la commande catch.",
+		"Throwing a %s at %s...\n":                                "Vous lancez une %s sur %s...\n",
+		"%s (level %d) was caught!\n":                             "%s (niveau %d) a été capturé !\n",
+		"Your party is full, so %s (ID %d) was sent to box %d.\n": "Votre équipe est pleine, %s (ID %d) a donc été envoyé dans la boîte %d.\n",
+		"%s (ID %d) joined your party.\n":                         "%s (ID %d) a rejoint votre équipe.\n",
+		"You may now inspect it with the inspect command.":        "Vous pouvez maintenant l'examiner avec la commande inspect.",
+		"%s escaped!\n":                                           "%s s'est échappé !\n",
+		"Name: %s":                                                "Nom : %s",
+		"ID: %d\nLevel: %d (%d XP)\n":                             "ID : %d\nNiveau : %d (%d Exp.)\n",
+		"Nature: %s\n":                                            "Nature : %s\n",
+		"Nature: %s (+%s, -%s)\n":                                 "Nature : %s (+%s, -%s)\n",
+		"Height: %d\nWeight: %d\n":                                "Taille : %d\nPoids : %d\n",
+		"Types: %s\n":                                             "Types : %s\n",
+		"Abilities: %s\n":                                         "Talents : %s\n",
+		"%s (hidden)":                                             "%s (caché)",
+		"Showing data from %s.\n":                                 "Affichage des données de %s.\n",
+		"Showing data from every game.":                           "Affichage des données de tous les jeux.",
+		"Showing names and messages in %s.\n":                     "Affichage des noms et des messages en %s.\n",
+		"Pokemon":                                                 "Pokémon",
+		"Area":                                                    "Zone",
+		"Stat":                                                    "Statistique",
+		"Base":                                                    "Base",
+		"%d %s cost %s, but you only have %s":                     "%d %s coûtent %s, mais vous n'avez que %s",
+		"%d locations in %s":                                      "%d lieux dans %s",
+		"%s %d base, %d at level %d\n":                            "%s %d de base, %d au niveau %d\n",
+		"%s %q not found":                                         "%s %q introuvable",
+		"%s %q not found. did you mean %s?":                       "%s %q introuvable. vouliez-vous dire %s ?",
+		"%s attacks %s for %d damage. (%d/%d HP left)\n":          "%s attaque %s et inflige %d dégâts. (%d/%d PV restants)\n",
+		"%s can't be found in the wild":                           "%s ne se trouve pas à l'état sauvage",
+		"%s can't be found that way. try without --version and --method": "%s ne se trouve pas ainsi. essayez sans --version ni --method",
+		"%s can't be sold":                                                      "%s ne peut pas être vendu",
+		"%s can't be used on a Pokemon":                                         "%s ne peut pas être utilisé sur un Pokémon",
+		"%s did not learn %s.\n":                                                "%s n'a pas appris %s.\n",
+		"%s fainted! The wild %s is still here.\n":                              "%s est K.O. ! Le %s sauvage est toujours là.\n",
+		"%s gained %d XP.\n":                                                    "%s gagne %d points d'Exp.\n",
+		"%s grew to level %d!\n":                                                "%s monte au niveau %d !\n",
+		"%s has fainted and can't battle":                                       "%s est K.O. et ne peut pas combattre",
+		"%s has fainted. use a revive first":                                    "%s est K.O. utilisez d'abord un rappel",
+		"%s has no areas to explore":                                            "%s n'a aucune zone à explorer",
+		"%s has no pokedex":                                                     "%s n'a pas de Pokédex",
+		"%s is in box %d. withdraw it to battle":                                "%s est dans la boîte %d. retirez-le pour combattre",
+		"%s is not a Poke Ball":                                                 "%s n'est pas une Poké Ball",
+		"%s is now called %s.\n":                                                "%s s'appelle maintenant %s.\n",
+		"%s joined your party.\n":                                               "%s a rejoint votre équipe.\n",
+		"%s learned %s!\n":                                                      "%s a appris %s !\n",
+		"%s recovered %d HP. (%d/%d HP)\n":                                      "%s récupère %d PV. (%d/%d PV)\n",
+		"%s wants to learn %s, but it already knows %d moves:\n":                "%s veut apprendre %s, mais connaît déjà %d capacités :\n",
+		"%s was deposited in box %d.\n":                                         "%s a été déposé dans la boîte %d.\n",
+		"%s was released. Bye, %s!\n":                                           "%s a été relâché. Au revoir, %s !\n",
+		"%s was revived! (%d/%d HP)\n":                                          "%s a été ranimé ! (%d/%d PV)\n",
+		"%s's nickname was removed.\n":                                          "Le surnom de %s a été retiré.\n",
+		"%w. look up any Pokemon with: lookup %s":                               "%w. consultez n'importe quel Pokémon avec : lookup %s",
+		"--min-stat takes stat=value pairs, like speed=100. stats are: %s, bst": "--min-stat prend des paires statistique=valeur, comme speed=100. les statistiques sont : %s, bst",
+		"--missing lists Pokemon you haven't caught, so it can't be filtered or sorted": "--missing liste les Pokémon que vous n'avez pas capturés, il ne peut donc pas être filtré ni trié",
+		"1, 2, and... Poof! %s forgot %s and learned %s!\n":                             "1, 2 et... Tadaa ! %s a oublié %s et appris %s !\n",
+		"Abilities":                       "Talents",
+		"Ability: %s":                     "Talent : %s",
+		"Accuracy: %d%%\n":                "Précision : %d%%\n",
+		"Accuracy: -":                     "Précision : -",
+		"Ailment: %s (%d%% chance)\n":     "Altération : %s (%d%% de chances)\n",
+		"Berry":                           "Baie",
+		"Box %d is empty.\n":              "La boîte %d est vide.\n",
+		"Box":                             "Boîte",
+		"Category":                        "Catégorie",
+		"Category: %s\n":                  "Catégorie : %s\n",
+		"Caught":                          "Capturés",
+		"Chance":                          "Chances",
+		"Choose a number from 1 to %d.\n": "Choisissez un nombre de 1 à %d.\n",
+		"Class: %s\n":                     "Classe : %s\n",
+		"Complete":                        "Complété",
+		"Critical hit stage: +%d\n":       "Taux de coup critique : +%d\n",
+		"Description: %s\n":               "Description : %s\n",
+		"Drain: heals %d%% of the damage dealt\n": "Absorption : soigne %d%% des dégâts infligés\n",
+		"Effect: %s\n":                   "Effet : %s\n",
+		"Encounter rate":                 "Taux de rencontre",
+		"Flinch chance: %d%%\n":          "Chances d'apeurer : %d%%\n",
+		"Fling power: %s\n":              "Puissance de Dégommage : %s\n",
+		"Games: %s\n":                    "Jeux : %s\n",
+		"Generation: %s\n":               "Génération : %s\n",
+		"Go, %s! (level %d, %d/%d HP)\n": "En avant, %s ! (niveau %d, %d/%d PV)\n",
+		"Height":                         "Taille",
+		"Immune to":                      "Immunisé contre",
+		"In bag":                         "Dans le sac",
+		"In your bag: %d\n":              "Dans votre sac : %d\n",
+		"It left %s behind as thanks.\n": "Il a laissé %s en guise de remerciement.\n",
+		"Item":                           "Objet",
+		"Item: %s":                       "Objet : %s",
+		"Kind":                           "Genre",
+		"Levels":                         "Niveaux",
+		"Location":                       "Lieu",
+		"Locations: %d (list them with: locations %s)\n": "Lieux : %d (listez-les avec : locations %s)\n",
+		"Lv %d":       "N. %d",
+		"Lv":          "N.",
+		"Match":       "Correspondance",
+		"Method":      "Méthode",
+		"Money: %s\n": "Argent : %s\n",
+		"Move":        "Capacité",
+		"Move: %s":    "Capacité : %s",
+		"Moves":       "Capacités",
+		"Name":        "Nom",
+		"Neither Pokemon could win. The battle is over.": "Aucun Pokémon n'a pu gagner. Le combat est terminé.",
+		"No Pokemon have this ability.":                  "Aucun Pokémon n'a ce talent.",
+		"No.":                                            "N°",
+		"None of your caught Pokemon match.":             "Aucun de vos Pokémon capturés ne correspond.",
+		"Owned":                                          "Possédé",
+		"PP: %s\n":                                       "PP : %s\n",
+		"Pokedex":                                        "Pokédex",
+		"Pokedexes: %s\n":                                "Pokédex : %s\n",
+		"Power: %s\n":                                    "Puissance : %s\n",
+		"Price":                                          "Prix",
+		"Price: %s\n":                                    "Prix : %s\n",
+		"Priority: %+d\n":                                "Priorité : %+d\n",
+		"Qty":                                            "Qté",
+		"Recoil: %d%% of the damage dealt\n":             "Contrecoup : %d%% des dégâts infligés\n",
+		"Region: %s":                                     "Région : %s",
+		"Resists":                                        "Résiste à",
+		"Ripe":                                           "Mûre",
+		"Seen":                                           "Vus",
+		"Slot":                                           "Place",
+		"Species":                                        "Espèce",
+		"Stage":                                          "Stade",
+		"Target: %s\n":                                   "Cible : %s\n",
+		"The wild %s fainted! You found %s.\n":           "Le %s sauvage est K.O. ! Vous avez trouvé %s.\n",
+		"Total:":                                         "Total :",
+		"Type: %s\n":                                     "Type : %s\n",
+		"Types":                                          "Types",
+		"Version":                                        "Version",
+		"Weak to":                                        "Faible contre",
+		"Weight":                                         "Poids",
+		"Welcome to the %s Poke Mart! You have %s.\n":                          "Bienvenue à la Boutique Pokémon de %s ! Vous avez %s.\n",
+		"What? %s is evolving! Congratulations! %s evolved into %s!\n":         "Quoi ? %s évolue ! Félicitations ! %s a évolué en %s !\n",
+		"Which move should be forgotten? (1-%d, or press enter to keep them) ": "Quelle capacité oublier ? (1-%d, ou Entrée pour les garder) ",
+		"You bought %d %s for %s. You have %s left.\n":                         "Vous avez acheté %d %s pour %s. Il vous reste %s.\n",
+		"You caught every Pokemon in it. Congratulations!":                     "Vous avez capturé tous ses Pokémon. Félicitations !",
+		"You haven't seen any Pokemon from %s yet.\n":                          "Vous n'avez encore vu aucun Pokémon de %s.\n",
+		"You picked %d %s!\n":                                       "Vous avez cueilli %d %s !\n",
+		"You planted %s in %s. It will be ripe in %s.\n":            "Vous avez planté %s dans %s. Elle sera mûre dans %s.\n",
+		"You sold %d %s for %s. You have %s now.\n":                 "Vous avez vendu %d %s pour %s. Vous avez maintenant %s.\n",
+		"Your Pokemon are fighting fit!":                            "Vos Pokémon sont en pleine forme !",
+		"can't read the save file %s: %w":                           "impossible de lire le fichier de sauvegarde %s : %w",
+		"can't save the game: %w":                                   "impossible de sauvegarder la partie : %w",
+		"can't show information on %s. you need to catch one first": "impossible d'afficher les infos de %s. vous devez d'abord en capturer un",
+		"can't show information on %s. you need to catch one first, or look it up with: lookup %s": "impossible d'afficher les infos de %s. vous devez d'abord en capturer un, ou le consulter avec : lookup %s",
+		"can't sort by %q. choose one of: %s":                                                      "impossible de trier par %q. choisissez parmi : %s",
+		"choose a box from 1 to %d":                                                                "choisissez une boîte de 1 à %d",
+		"flag --%s doesn't take a value":                                                           "l'option --%s ne prend pas de valeur",
+		"flag --%s expects a number, got %q":                                                       "l'option --%s attend un nombre, reçu %q",
+		"flag --%s requires a value":                                                               "l'option --%s nécessite une valeur",
+		"hidden":                                                                                   "caché",
+		"in %s":                                                                                    "dans %s",
+		"invalid page %q: use a page number, first or last":                                        "page invalide %q : utilisez un numéro de page, first ou last",
+		"it won't have any effect on %s":                                                           "ça n'aura aucun effet sur %s",
+		"it won't have any effect. %s hasn't fainted":                                              "ça n'aura aucun effet. %s n'est pas K.O.",
+		"it won't have any effect. %s is at full health":                                           "ça n'aura aucun effet. %s a tous ses PV",
+		"it won't have any effect. %s is at the highest level":                                     "ça n'aura aucun effet. %s est au niveau maximum",
+		"language: %s\n":                                                                           "langue : %s\n",
+		"mode: %s\n":                                                                               "mode : %s\n",
+		"nicknames can be at most %d characters long":                                              "les surnoms font au plus %d caractères",
+		"nicknames can't be numbers, they would be mistaken for IDs":                               "les surnoms ne peuvent pas être des nombres, ils seraient confondus avec des ID",
+		"no Pokemon can be encountered by %s in %s. try: %s":                                       "aucun Pokémon ne peut être rencontré par %s dans %s. essayez : %s",
+		"no moves found for %s with the given filters":                                             "aucune capacité trouvée pour %s avec ces filtres",
+		"no natures found":                                                                         "aucune nature trouvée",
+		"none of the berries in %s are ripe yet. check them with the plots command":                "aucune des baies de %s n'est encore mûre. vérifiez-les avec la commande plots",
+		"nothing is planted in %s. plant a berry with the plant command":                           "rien n'est planté dans %s. plantez une baie avec la commande plant",
+		"nothing matches %q":                                                                       "rien ne correspond à %q",
+		"now":                                                                                      "maintenant",
+		"output: %s\n":                                                                             "sortie : %s\n",
+		"page %d doesn't exist, there are %d pages":                                                "la page %d n'existe pas, il y a %d pages",
+		"page %d of %d (%d areas)":                                                                 "page %d sur %d (%d zones)",
+		"the %s Poke Mart doesn't sell %s. see what it sells with the shop command": "la Boutique Pokémon de %s ne vend pas %s. voyez ce qu'elle vend avec la commande shop",
+		"the limit must be at least 1":                                                     "la limite doit être d'au moins 1",
+		"the minimum %s must be a number, got %q":                                          "le minimum de %s doit être un nombre, reçu %q",
+		"the quantity must be a positive number, got %q":                                   "la quantité doit être un nombre positif, reçu %q",
+		"there's no Poke Mart in %s. Poke Marts are in towns and cities":                   "il n'y a pas de Boutique Pokémon dans %s. les Boutiques Pokémon sont en ville",
+		"there's no wild %s here. you encountered %s":                                      "il n'y a pas de %s sauvage ici. vous avez rencontré %s",
+		"there's no wild Pokemon to battle. use the encounter command to find one":         "il n'y a aucun Pokémon sauvage à combattre. utilisez la commande encounter pour en trouver un",
+		"there's no wild Pokemon to catch. use the encounter command to find one":          "il n'y a aucun Pokémon sauvage à capturer. utilisez la commande encounter pour en trouver un",
+		"throw balls at wild Pokemon with: catch --ball %s":                                "lancez des Balls sur les Pokémon sauvages avec : catch --ball %s",
+		"trailing backslash in input":                                                      "barre oblique inverse en fin de saisie",
+		"translated messages: %s\n":                                                        "messages traduits : %s\n",
+		"unknown command %q":                                                               "commande inconnue %q",
+		"unknown flag --%s":                                                                "option inconnue --%s",
+		"unknown kind %q (choose from pokemon, location)":                                  "genre inconnu %q (choisissez parmi pokemon, location)",
+		"unknown mode %q. choose game or reference":                                        "mode inconnu %q. choisissez game ou reference",
+		"unknown setting %q":                                                               "paramètre inconnu %q",
+		"unterminated double quote in input":                                               "guillemet double non fermé dans la saisie",
+		"unterminated single quote in input":                                               "apostrophe non fermée dans la saisie",
+		"usage: %s <item> [quantity]":                                                      "utilisation : %s <item> [quantity]",
+		"usage: battle [pokemon]":                                                          "utilisation : battle [pokemon]",
+		"usage: catch [pokemon_name] [--ball <ball>]":                                      "utilisation : catch [pokemon_name] [--ball <ball>]",
+		"usage: compare <pokemon> <pokemon> [pokemon...] [--any]":                          "utilisation : compare <pokemon> <pokemon> [pokemon...] [--any]",
+		"usage: deposit <pokemon> [box]":                                                   "utilisation : deposit <pokemon> [box]",
+		"usage: encounter [walk|surf|fish <old-rod|good-rod|super-rod>]":                   "utilisation : encounter [walk|surf|fish <old-rod|good-rod|super-rod>]",
+		"usage: language [<code>]":                                                         "utilisation : language [<code>]",
+		"usage: map [page|first|last] [--limit N]":                                         "utilisation : map [page|first|last] [--limit N]",
+		"usage: moves <pokemon> [--method level-up|machine|egg|tutor] [--version-group X]": "utilisation : moves <pokemon> [--method level-up|machine|egg|tutor] [--version-group X]",
+		"usage: nickname <pokemon> <nickname> (use \"\" to remove a nickname)":             "utilisation : nickname <pokemon> <nickname> (use \"\" to remove a nickname)",
+		"usage: plant <berry>":                                                             "utilisation : plant <berry>",
+		"usage: pokedex [--region <region>] [--missing] [--type <type>] [--min-stat <stat=value>] [--sort <order>] [--desc] [--limit N]": "utilisation : pokedex [--region <region>] [--missing] [--type <type>] [--min-stat <stat=value>] [--sort <order>] [--desc] [--limit N]",
+		"usage: release <pokemon>":     "utilisation : release <pokemon>",
+		"usage: set <setting> <value>": "utilisation : set <setting> <value>",
+		"usage: use <item> <pokemon>":  "utilisation : use <item> <pokemon>",
+		"usage: version [<game>|all]":  "utilisation : version [<game>|all]",
+		"usage: withdraw <pokemon>":    "utilisation : withdraw <pokemon>",
+		"version: %s\n":                "version : %s\n",
+		"warning: can't load %d of your caught Pokemon, they'll be loaded next time: %s\n": "attention : impossible de charger %d de vos Pokémon capturés, ils seront chargés la prochaine fois : %s\n",
+		"you aren't anywhere yet. explore a location first":                                "vous n'êtes encore nulle part. explorez d'abord un lieu",
+		"you can trade at most %d items at once":                                           "vous pouvez échanger au plus %d objets à la fois",
+		"you don't have a Pokemon called %s":                                               "vous n'avez pas de Pokémon nommé %s",
+		"you don't have a Pokemon with ID %d":                                              "vous n'avez pas de Pokémon avec l'ID %d",
+		"you don't have any %s to plant":                                                   "vous n'avez pas de %s à planter",
+		"you don't have any %s":                                                            "vous n'avez pas de %s",
+		"you don't have any %s. check your bag with the bag command":                       "vous n'avez pas de %s. vérifiez votre sac avec la commande bag",
+		"you have %d Pokemon called %s. use one of their IDs: %s":                          "vous avez %d Pokémon nommés %s. utilisez l'un de leurs ID : %s",
+		"you have no Pokemon that can battle":                                              "vous n'avez aucun Pokémon capable de combattre",
+		"you haven't caught %s. compare any Pokemon with --any":                            "vous n'avez pas capturé %s. comparez n'importe quel Pokémon avec --any",
+		"you haven't planted any berries":                                                  "vous n'avez planté aucune baie",
+		"you must explore an area for Pokemon encounters first":                            "vous devez d'abord explorer une zone pour rencontrer des Pokémon",
+		"you must provide a location name":                                                 "vous devez indiquer un nom de lieu",
+		"you must provide a move name":                                                     "vous devez indiquer un nom de capacité",
+		"you must provide a pokemon name":                                                  "vous devez indiquer un nom de Pokémon",
+		"you must provide a region name":                                                   "vous devez indiquer un nom de région",
+		"you must provide a search term":                                                   "vous devez indiquer un terme de recherche",
+		"you must provide an ability name":                                                 "vous devez indiquer un nom de talent",
+		"you must provide an item name":                                                    "vous devez indiquer un nom d'objet",
+		"you only have %d %s":                                                              "vous n'avez que %d %s",
+		"you're on the first page":                                                         "vous êtes sur la première page",
+		"you're on the last page":                                                          "vous êtes sur la dernière page",
+		"your bag is empty. you have %s":                                                   "votre sac est vide. vous avez %s",
+		"your party is empty. go catch some pokemon":                                       "votre équipe est vide. allez capturer des Pokémon",
+		"your pokedex is empty. go explore and catch some pokemon":                         "votre Pokédex est vide. allez explorer et capturer des Pokémon",
+
+		"Displays a help message": "Affiche un message d'aide",
+		"Exit the Pokedex":        "Quitte le Pokédex",
+		"Displays the next page of location-areas, or jumps to the given page":               "Affiche la page suivante des zones, ou va à la page indiquée",
+		"Displays the previous page of location-areas":                                       "Affiche la page précédente des zones",
+		"Displays all Pokemon in the area given, or in every area of a location":             "Affiche tous les Pokémon de la zone indiquée, ou de toutes les zones d'un lieu",
+		"Lists the location areas where a Pokemon can be found":                              "Liste les zones où l'on peut trouver un Pokémon",
+		"Limits encounters, moves, types and descriptions to one game, e.g. version diamond": "Limite les rencontres, capacités, types et descriptions à un jeu, p. ex. version diamond",
+		"Shows names and messages in a language, e.g. language es":                           "Affiche les noms et messages dans une langue, p. ex. language es",
+		"Lists all regions":                             "Liste toutes les régions",
+		"Shows details about a region":                  "Affiche les détails d'une région",
+		"Lists the locations in a region":               "Liste les lieux d'une région",
+		"Looks for a wild Pokemon in the explored area": "Cherche un Pokémon sauvage dans la zone explorée",
+		"Throws a Poke Ball, or the ball given, at the wild Pokemon you encountered":      "Lance une Poké Ball, ou la Ball indiquée, sur le Pokémon sauvage rencontré",
+		"Shows details about a caught Pokemon, or any Pokemon with --any":                 "Affiche les détails d'un Pokémon capturé, ou de n'importe quel Pokémon avec --any",
+		"Shows details about any Pokemon, caught or not":                                  "Affiche les détails de n'importe quel Pokémon, capturé ou non",
+		"Lists the Pokemon you have seen and caught, and how complete your pokedex is":    "Liste les Pokémon vus et capturés, et l'avancement de ton Pokédex",
+		"Shows Pokemon side by side: stats, types, abilities, size and type matchups":     "Affiche des Pokémon côte à côte : statistiques, types, talents, taille et efficacité des types",
+		"Shows a move's type, power, accuracy, PP and effect":                             "Affiche le type, la puissance, la précision, les PP et l'effet d'une capacité",
+		"Lists the moves a Pokemon can learn":                                             "Liste les capacités qu'un Pokémon peut apprendre",
+		"Shows what an ability does and which Pokemon can have it":                        "Affiche l'effet d'un talent et les Pokémon qui peuvent l'avoir",
+		"Finds Pokemon and location-areas with names similar to the term":                 "Trouve les Pokémon et zones dont le nom ressemble au terme",
+		"Displays the Pokemon in your party":                                              "Affiche les Pokémon de ton équipe",
+		"Displays the Pokemon in a PC box, or how full the boxes are":                     "Affiche les Pokémon d'une boîte du PC, ou le remplissage des boîtes",
+		"Moves a Pokemon from your party into a PC box":                                   "Dépose un Pokémon de ton équipe dans une boîte du PC",
+		"Moves a Pokemon from a PC box into your party":                                   "Retire un Pokémon d'une boîte du PC vers ton équipe",
+		"Gives one of your Pokemon a nickname":                                            "Donne un surnom à l'un de tes Pokémon",
+		"Releases one of your Pokemon back into the wild":                                 "Relâche l'un de tes Pokémon dans la nature",
+		"Fights the wild Pokemon you encountered with your lead Pokemon or the one given": "Combat le Pokémon sauvage rencontré avec ton premier Pokémon ou celui indiqué",
+		"Restores the HP of the Pokemon in your party":                                    "Restaure les PV des Pokémon de ton équipe",
+		"Displays the items in your bag":                                                  "Affiche les objets de ton sac",
+		"Shows an item's category, price and effect":                                      "Affiche la catégorie, le prix et l'effet d'un objet",
+		"Uses an item from your bag on one of your Pokemon":                               "Utilise un objet de ton sac sur l'un de tes Pokémon",
+		"Lists the items sold at the Poke Mart of the explored location":                  "Liste les objets vendus à la Boutique Pokémon du lieu exploré",
+		"Buys items at the Poke Mart":                                                     "Achète des objets à la Boutique Pokémon",
+		"Sells items from your bag at the Poke Mart for half their price":                 "Vend des objets de ton sac à la Boutique Pokémon pour la moitié de leur prix",
+		"Plants a berry from your bag in a soil plot at the explored location":            "Plante une baie de ton sac dans une parcelle du lieu exploré",
+		"Picks the ripe berries at the explored location":                                 "Cueille les baies mûres du lieu exploré",
+		"Displays the berries you planted and when they will be ripe":                     "Affiche les baies plantées et quand elles seront mûres",
+		"Changes a setting: output (table, json, yaml, csv), mode (game, reference), version (a game or all) or language (a language code), e.g. set output json": "Modifie un réglage : output (table, json, yaml, csv), mode (game, reference), version (un jeu ou all) ou language (un code de langue), p. ex. set output json",
+		"ability":       "talent",
+		"berry":         "baie",
+		"item":          "objet",
+		"language":      "langue",
+		"location area": "zone",
+		"move":          "capacité",
+		"pokemon":       "pokémon",
+		"region":        "région",
+		"version":       "version",
+		"wild %s":       "%s sauvage",
+		"party":         "équipe",
+		"box %d":        "boîte %d",
+	},
+}
@@ -0,0 +1,128 @@
+package messages
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Printer formats the CLI's messages in one language. Messages are looked up
+// by their English format string, so those without a translation, and every
+// message of a language without a catalog, are printed in English.
+// The zero value prints English.
+type Printer struct {
+	catalog map[string]string
+}
+
+/*
+New creates a Printer for a language.
+
+Parameters:
+- language: The PokeAPI language code, e.g. "es".
+
+Returns:
+- Printer: A Printer using the language's catalog, if there is one.
+*/
+func New(language string) Printer {
+	return Printer{catalog: catalogs[language]}
+}
+
+// translate returns the translation of an English format string, or the string itself.
+func (p Printer) translate(format string) string {
+	if translated, ok := p.catalog[format]; ok {
+		return translated
+	}
+	return format
+}
+
+// Sprintf formats the translation of an English format string.
+func (p Printer) Sprintf(format string, args ...any) string {
+	return fmt.Sprintf(p.translate(format), args...)
+}
+
+// Printf prints the translation of an English format string to standard output.
+func (p Printer) Printf(format string, args ...any) {
+	fmt.Print(p.Sprintf(format, args...))
+}
+
+// Translate returns the translation of an English text.
+func (p Printer) Translate(text Text) string {
+	return p.translate(string(text))
+}
+
+/*
+Error returns an error's message in the Printer's language. Errors made by
+Errorf are translated, along with the errors and texts they're given, and
+other errors are shown as they are.
+
+Parameters:
+- err: The error to show.
+
+Returns:
+- string: The error's message, e.g. "no existe ningún pokemon llamado pikachuu".
+*/
+func (p Printer) Error(err error) string {
+	e, ok := err.(*Error)
+	if !ok {
+		return err.Error()
+	}
+	args := make([]any, len(e.args))
+	for i, arg := range e.args {
+		switch a := arg.(type) {
+		case error:
+			arg = p.Error(a)
+		case Text:
+			arg = p.Translate(a)
+		}
+		args[i] = arg
+	}
+	return fmt.Sprintf(strings.ReplaceAll(p.translate(e.format), "%w", "%v"), args...)
+}
+
+// Text is an English message without verbs, such as a command's description.
+// Given to Errorf, it is translated along with the error's message.
+type Text string
+
+// Error is an error whose message is translated when a Printer shows it.
+// Its Error method returns the message in English.
+type Error struct {
+	format string
+	args   []any
+	err    error
+}
+
+// Errorf makes an Error from an English format string, like fmt.Errorf.
+func Errorf(format string, args ...any) error {
+	return &Error{format: format, args: args, err: fmt.Errorf(format, args...)}
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the errors given for the %w verbs of the message.
+func (e *Error) Unwrap() []error {
+	switch err := e.err.(type) {
+	case interface{ Unwrap() error }:
+		return []error{err.Unwrap()}
+	case interface{ Unwrap() []error }:
+		return err.Unwrap()
+	}
+	return nil
+}
+
+// Languages returns the languages that have a message catalog, sorted.
+func Languages() []string {
+	return slices.Sorted(maps.Keys(catalogs))
+}
+
+// Formats returns the English messages that have a translation, sorted.
+func Formats() []string {
+	formats := []string{}
+	for _, catalog := range catalogs {
+		formats = append(formats, slices.Collect(maps.Keys(catalog))...)
+	}
+	slices.Sort(formats)
+	return slices.Compact(formats)
+}
@@ -0,0 +1,101 @@
+package messages
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPrinter(t *testing.T) {
+	cases := []struct {
+		language string
+		format   string
+		args     []any
+		expected string
+	}{
+		{language: "es", format: "%s escaped!\n", args: []any{"pikachu"}, expected: "¡pikachu escapó!\n"},
+		{language: "fr", format: "Types: %s\n", args: []any{"[eau]"}, expected: "Types : [eau]\n"},
+		{language: "es", format: "Not translated: %d", args: []any{3}, expected: "Not translated: 3"},
+		{language: "ja", format: "%s escaped!\n", args: []any{"pikachu"}, expected: "pikachu escaped!\n"},
+		{language: "en", format: "No Pokemon found.", expected: "No Pokemon found."},
+	}
+	for _, c := range cases {
+		if got := New(c.language).Sprintf(c.format, c.args...); got != c.expected {
+			t.Errorf("%s: expected %q, got %q", c.language, c.expected, got)
+		}
+	}
+	if got := (Printer{}).Sprintf("%s escaped!\n", "pikachu"); got != "pikachu escaped!\n" {
+		t.Errorf("zero Printer: expected English, got %q", got)
+	}
+	if got := New("fr").Translate("Lists all regions"); got != "Liste toutes les régions" {
+		t.Errorf("expected a translated text, got %q", got)
+	}
+	if got := New("ja").Translate("Lists all regions"); got != "Lists all regions" {
+		t.Errorf("expected an untranslated text in English, got %q", got)
+	}
+}
+
+var verbPattern = regexp.MustCompile(`%(\[\d+\])?[-+# 0]*\d*(\.\d+)?[a-zA-Z%]`)
+
+// verbs returns the formatting verbs of a message without argument indexes, sorted.
+func verbs(format string) []string {
+	found := []string{}
+	for _, match := range verbPattern.FindAllStringSubmatch(format, -1) {
+		found = append(found, strings.Replace(match[0], match[1], "", 1))
+	}
+	slices.Sort(found)
+	return found
+}
+
+func TestCatalogsKeepVerbs(t *testing.T) {
+	for _, language := range Languages() {
+		for format, translated := range catalogs[language] {
+			if !slices.Equal(verbs(format), verbs(translated)) {
+				t.Errorf("%s: %q has the verbs %v, but its translation %q has %v", language, format, verbs(format), translated, verbs(translated))
+			}
+			if strings.HasSuffix(format, "\n") != strings.HasSuffix(translated, "\n") {
+				t.Errorf("%s: the translation %q of %q must keep its trailing newline", language, translated, format)
+			}
+		}
+	}
+}
+
+func TestCatalogsHaveSameMessages(t *testing.T) {
+	formats := Formats()
+	for _, language := range Languages() {
+		for _, format := range formats {
+			if _, ok := catalogs[language][format]; !ok {
+				t.Errorf("%s: missing a translation of %q", language, format)
+			}
+		}
+	}
+}
+
+func TestPrinterError(t *testing.T) {
+	errOffline := errors.New("connection refused")
+	err := Errorf("can't save the game: %w", Errorf("unknown command %q", "fly"))
+	cases := []struct {
+		language string
+		err      error
+		expected string
+	}{
+		{language: "es", err: err, expected: `no se puede guardar la partida: comando desconocido "fly"`},
+		{language: "en", err: err, expected: `can't save the game: unknown command "fly"`},
+		{language: "fr", err: Errorf("can't save the game: %w", errOffline), expected: "impossible de sauvegarder la partie : connection refused"},
+		{language: "de", err: errOffline, expected: "connection refused"},
+		{language: "es", err: Errorf("%s %q not found", Text("move"), "surff"), expected: `no se encontró movimiento "surff"`},
+	}
+	for _, c := range cases {
+		if got := New(c.language).Error(c.err); got != c.expected {
+			t.Errorf("%s: expected %q, got %q", c.language, c.expected, got)
+		}
+	}
+	if wrapped := Errorf("can't save the game: %w", errOffline); !errors.Is(wrapped, errOffline) || wrapped.Error() != "can't save the game: connection refused" {
+		t.Errorf("expected an English error wrapping errOffline, got %q", wrapped)
+	}
+	if err := Errorf("%s %q not found", Text("move"), "surff"); err.Error() != `move "surff" not found` {
+		t.Errorf("expected an English error, got %q", err)
+	}
+}
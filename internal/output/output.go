@@ -0,0 +1,341 @@
+package output
+
+import (
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Format is a way of printing command results.
+type Format string
+
+const (
+	Table Format = "table"
+	JSON  Format = "json"
+	YAML  Format = "yaml"
+	CSV   Format = "csv"
+)
+
+// Formats lists every supported output format.
+var Formats = []Format{Table, JSON, YAML, CSV}
+
+/*
+ParseFormat converts a user-supplied name into a Format.
+
+Parameters:
+- name: The format name, e.g. "json".
+
+Returns:
+- Format: The matching format.
+- error: An error if the name isn't a known format.
+*/
+func ParseFormat(name string) (Format, error) {
+	for _, f := range Formats {
+		if strings.EqualFold(name, string(f)) {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown output format %q (choose from table, json, yaml, csv)", name)
+}
+
+/*
+Write encodes v to w in the given structured format.
+
+Struct fields are named after their json tags, so the same record types can be
+written as JSON, YAML or CSV. For CSV, v should be a slice of records (a single
+record is written as one row); nested fields become dotted column names and
+lists of plain values are joined with ';'.
+
+The Table format is rendered by the caller, so passing it is an error.
+
+Parameters:
+- w: The writer to encode to.
+- format: The structured format to use.
+- v: The records to encode.
+
+Returns:
+- error: An error if encoding or writing fails.
+*/
+func Write(w io.Writer, format Format, v any) error {
+	switch format {
+	case JSON:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case YAML:
+		var sb strings.Builder
+		writeYAML(&sb, toNode(reflect.ValueOf(v)), 0)
+		_, err := io.WriteString(w, sb.String())
+		return err
+	case CSV:
+		return writeCSV(w, toNode(reflect.ValueOf(v)))
+	default:
+		return fmt.Errorf("format %q can't be written as structured output", format)
+	}
+}
+
+// node is a format-neutral view of a value: a scalar, a list or an ordered mapping.
+type node struct {
+	kind   nodeKind
+	scalar string
+	quoted bool // the scalar is a string rather than a number, bool or null
+	items  []node
+	keys   []string
+}
+
+type nodeKind int
+
+const (
+	scalarNode nodeKind = iota
+	listNode
+	mapNode
+)
+
+var textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+
+// toNode converts a value into a node, naming struct fields the way encoding/json does.
+func toNode(v reflect.Value) node {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return node{kind: scalarNode, scalar: "null"}
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return node{kind: scalarNode, scalar: "null"}
+	}
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err == nil {
+			return node{kind: scalarNode, scalar: string(text), quoted: true}
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return node{kind: scalarNode, scalar: v.String(), quoted: true}
+	case reflect.Bool:
+		return node{kind: scalarNode, scalar: strconv.FormatBool(v.Bool())}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return node{kind: scalarNode, scalar: strconv.FormatInt(v.Int(), 10)}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return node{kind: scalarNode, scalar: strconv.FormatUint(v.Uint(), 10)}
+	case reflect.Float32, reflect.Float64:
+		return node{kind: scalarNode, scalar: strconv.FormatFloat(v.Float(), 'f', -1, 64)}
+	case reflect.Slice, reflect.Array:
+		n := node{kind: listNode, items: []node{}}
+		for i := 0; i < v.Len(); i++ {
+			n.items = append(n.items, toNode(v.Index(i)))
+		}
+		return n
+	case reflect.Map:
+		n := node{kind: mapNode}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, k := range keys {
+			n.keys = append(n.keys, fmt.Sprint(k.Interface()))
+			n.items = append(n.items, toNode(v.MapIndex(k)))
+		}
+		return n
+	case reflect.Struct:
+		n := node{kind: mapNode}
+		addStructFields(&n, v)
+		return n
+	default:
+		return node{kind: scalarNode, scalar: fmt.Sprint(v.Interface()), quoted: true}
+	}
+}
+
+// addStructFields appends the exported fields of a struct to a mapping node.
+func addStructFields(n *node, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		fv := v.Field(i)
+		if field.Anonymous && name == "" && fv.Kind() == reflect.Struct {
+			addStructFields(n, fv)
+			continue
+		}
+		if strings.Contains(opts, "omitempty") && fv.IsZero() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		n.keys = append(n.keys, name)
+		n.items = append(n.items, toNode(fv))
+	}
+}
+
+// writeYAML writes a node as a block-style YAML document at the given indentation.
+func writeYAML(sb *strings.Builder, n node, indent int) {
+	pad := strings.Repeat("  ", indent)
+	switch n.kind {
+	case scalarNode:
+		sb.WriteString(pad + yamlScalar(n) + "\n")
+	case listNode:
+		if len(n.items) == 0 {
+			sb.WriteString(pad + "[]\n")
+			return
+		}
+		for _, item := range n.items {
+			sb.WriteString(pad + "-")
+			writeYAMLValue(sb, item, indent+1, true)
+		}
+	case mapNode:
+		if len(n.keys) == 0 {
+			sb.WriteString(pad + "{}\n")
+			return
+		}
+		for i, key := range n.keys {
+			sb.WriteString(pad + yamlScalar(node{scalar: key, quoted: true}) + ":")
+			writeYAMLValue(sb, n.items[i], indent+1, false)
+		}
+	}
+}
+
+// writeYAMLValue writes the value following a "key:" or "-" marker.
+// Mappings inside list items start on the same line as the dash.
+func writeYAMLValue(sb *strings.Builder, n node, indent int, inList bool) {
+	switch {
+	case n.kind == scalarNode || len(n.items) == 0:
+		sb.WriteString(" ")
+		writeYAML(sb, n, 0)
+	case n.kind == mapNode && inList:
+		var inner strings.Builder
+		writeYAML(&inner, n, indent)
+		sb.WriteString(" " + strings.TrimPrefix(inner.String(), strings.Repeat("  ", indent)))
+	default:
+		sb.WriteString("\n")
+		writeYAML(sb, n, indent)
+	}
+}
+
+// yamlScalar formats a scalar, quoting strings that YAML would otherwise misread.
+func yamlScalar(n node) string {
+	if !n.quoted {
+		return n.scalar
+	}
+	if needsQuotes(n.scalar) {
+		quoted, _ := json.Marshal(n.scalar)
+		return string(quoted)
+	}
+	return n.scalar
+}
+
+func needsQuotes(s string) bool {
+	if s == "" || strings.TrimSpace(s) != s {
+		return true
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~":
+		return true
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return true
+	}
+	if strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@`") {
+		return true
+	}
+	return strings.Contains(s, ": ") || strings.Contains(s, " #") || strings.ContainsAny(s, "\n\t\\")
+}
+
+// writeCSV writes a list of records as CSV rows with a header line.
+func writeCSV(w io.Writer, n node) error {
+	rows := []node{n}
+	if n.kind == listNode {
+		rows = n.items
+	}
+
+	header := []string{}
+	seen := map[string]bool{}
+	flatRows := []map[string]string{}
+	for _, row := range rows {
+		flat := map[string]string{}
+		for _, cell := range flatten("", row) {
+			if !seen[cell[0]] {
+				seen[cell[0]] = true
+				header = append(header, cell[0])
+			}
+			flat[cell[0]] = cell[1]
+		}
+		flatRows = append(flatRows, flat)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, flat := range flatRows {
+		record := make([]string, len(header))
+		for i, col := range header {
+			record[i] = flat[col]
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// flatten turns a node into (column, value) pairs, joining nested keys with dots.
+func flatten(prefix string, n node) [][2]string {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+
+	switch n.kind {
+	case mapNode:
+		cells := [][2]string{}
+		for i, key := range n.keys {
+			cells = append(cells, flatten(join(key), n.items[i])...)
+		}
+		return cells
+	case listNode:
+		values := []string{}
+		for _, item := range n.items {
+			if item.kind != scalarNode {
+				cells := [][2]string{}
+				for i, item := range n.items {
+					cells = append(cells, flatten(join(strconv.Itoa(i)), item)...)
+				}
+				return cells
+			}
+			values = append(values, item.scalar)
+		}
+		if prefix == "" {
+			prefix = "value"
+		}
+		return [][2]string{{prefix, strings.Join(values, ";")}}
+	default:
+		if prefix == "" {
+			prefix = "value"
+		}
+		if n.scalar == "null" && !n.quoted {
+			return [][2]string{{prefix, ""}}
+		}
+		return [][2]string{{prefix, n.scalar}}
+	}
+}
@@ -0,0 +1,88 @@
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+type testStats struct {
+	HP    int `json:"hp"`
+	Speed int `json:"speed"`
+}
+
+type testRecord struct {
+	Name   string    `json:"name"`
+	Types  []string  `json:"types"`
+	Stats  testStats `json:"stats"`
+	Hidden string    `json:"-"`
+	Note   string    `json:"note,omitempty"`
+}
+
+var testRecords = []testRecord{
+	{Name: "pikachu", Types: []string{"electric"}, Stats: testStats{HP: 35, Speed: 90}, Hidden: "x"},
+	{Name: "mr-mime", Types: []string{"psychic", "fairy"}, Stats: testStats{HP: 40, Speed: 90}, Note: "yes"},
+}
+
+func TestParseFormat(t *testing.T) {
+	cases := map[string]Format{
+		"json":  JSON,
+		"YAML":  YAML,
+		"csv":   CSV,
+		"table": Table,
+	}
+	for input, expected := range cases {
+		actual, err := ParseFormat(input)
+		if err != nil || actual != expected {
+			t.Errorf("ParseFormat(%q) = %q, %v; expected %q", input, actual, err, expected)
+		}
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Errorf("expected an error for an unknown format")
+	}
+}
+
+func TestWriteYAML(t *testing.T) {
+	expected := `- name: pikachu
+  types:
+    - electric
+  stats:
+    hp: 35
+    speed: 90
+- name: mr-mime
+  types:
+    - psychic
+    - fairy
+  stats:
+    hp: 40
+    speed: 90
+  note: "yes"
+`
+	var sb strings.Builder
+	if err := Write(&sb, YAML, testRecords); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sb.String() != expected {
+		t.Errorf("Expecting:\n%s\nActual:\n%s", expected, sb.String())
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	expected := `name,types,stats.hp,stats.speed,note
+pikachu,electric,35,90,
+mr-mime,psychic;fairy,40,90,yes
+`
+	var sb strings.Builder
+	if err := Write(&sb, CSV, testRecords); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sb.String() != expected {
+		t.Errorf("Expecting:\n%s\nActual:\n%s", expected, sb.String())
+	}
+}
+
+func TestWriteTable(t *testing.T) {
+	var sb strings.Builder
+	if err := Write(&sb, Table, testRecords); err == nil {
+		t.Errorf("expected an error for the table format")
+	}
+}
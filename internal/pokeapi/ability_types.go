@@ -0,0 +1,33 @@
+package pokeapi
+
+// Ability - a passive effect a Pokémon has in battle or in the overworld
+type Ability struct {
+	ID                int             `json:"id"`
+	Name              string          `json:"name"`
+	IsMainSeries      bool            `json:"is_main_series"`
+	Generation        Resource        `json:"generation"`
+	EffectEntries     []VerboseEffect `json:"effect_entries"`
+	Names             []Name          `json:"names"`
+	FlavorTextEntries []FlavorText    `json:"flavor_text_entries"`
+	Pokemon           []struct {
+		IsHidden bool     `json:"is_hidden"`
+		Slot     int      `json:"slot"`
+		Pokemon  Resource `json:"pokemon"`
+	} `json:"pokemon"`
+}
+
+// VerboseEffect - an effect description in one language, in full and in short
+type VerboseEffect struct {
+	Effect      string   `json:"effect"`
+	ShortEffect string   `json:"short_effect"`
+	Language    Resource `json:"language"`
+}
+
+// FlavorText - the in-game description of a resource in one language and game.
+// Pokémon species are described per version, other resources per version group.
+type FlavorText struct {
+	FlavorText   string    `json:"flavor_text"`
+	Language     Resource  `json:"language"`
+	Version      *Resource `json:"version"`
+	VersionGroup *Resource `json:"version_group"`
+}
@@ -0,0 +1,20 @@
+package pokeapi
+
+// Berry - a small fruit that can be planted, grown and harvested
+type Berry struct {
+	ID               int      `json:"id"`
+	Name             string   `json:"name"`
+	GrowthTime       int      `json:"growth_time"`
+	MaxHarvest       int      `json:"max_harvest"`
+	NaturalGiftPower int      `json:"natural_gift_power"`
+	Size             int      `json:"size"`
+	Smoothness       int      `json:"smoothness"`
+	SoilDryness      int      `json:"soil_dryness"`
+	Firmness         Resource `json:"firmness"`
+	Flavors          []struct {
+		Potency int      `json:"potency"`
+		Flavor  Resource `json:"flavor"`
+	} `json:"flavors"`
+	Item            Resource `json:"item"`
+	NaturalGiftType Resource `json:"natural_gift_type"`
+}
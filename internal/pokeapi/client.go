@@ -2,8 +2,10 @@ package pokeapi
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"github.com/OferRavid/pokedexcli/internal/pokecache"
@@ -11,82 +13,115 @@ import (
 
 // Client handles HTTP requests to the PokéAPI with caching functionality.
 type Client struct {
-	cache      pokecache.Cache
-	httpClient http.Client
+	cache       pokecache.Cache
+	spriteCache pokecache.DiskCache
+	httpClient  http.Client
 }
 
 /*
 NewClient creates and returns a new Client instance.
 
-The client includes a cache for API responses and a configured HTTP client.
+The client includes a cache for API responses, an on-disk cache for sprite
+images and a configured HTTP client.
 
 Parameters:
 - timeout: The maximum duration for an HTTP request.
 - cacheInterval: The expiration interval for cached responses.
+- cacheDir: The directory for files that are kept between runs, or "" to keep nothing on disk.
 
 Returns:
 - Client: A new API client instance.
 */
-func NewClient(timeout, cacheInterval time.Duration) Client {
-	return Client{
+func NewClient(timeout, cacheInterval time.Duration, cacheDir string) Client {
+	client := Client{
 		cache: pokecache.NewCache(cacheInterval),
 		httpClient: http.Client{
 			Timeout: timeout,
 		},
 	}
+	if cacheDir != "" {
+		client.spriteCache = pokecache.NewDiskCache(filepath.Join(cacheDir, "sprites"))
+	}
+	return client
+}
+
+// SetTransport makes the client send its requests through transport, e.g.
+// one that answers them from fixtures in tests.
+func (c *Client) SetTransport(transport http.RoundTripper) {
+	c.httpClient.Transport = transport
 }
 
 /*
-ListLocations retrieves a paginated list of location areas from the PokéAPI.
+getJSON fetches a PokéAPI URL and decodes the JSON response into v.
 
-If the requested page URL is cached, the cached response is returned.
+If the URL is cached, the cached response is used; otherwise the response
+body is added to the cache after it decodes successfully.
 
 Parameters:
-- pageURL: An optional pointer to a string representing the next/previous page URL.
+- url: The full URL to fetch.
+- v: A pointer to the value to decode into.
 
 Returns:
-- LocationAreaList: The response containing location areas.
-- error: An error if the request or JSON decoding fails.
+- error: ErrNotFound if the resource doesn't exist, or an error if the request or JSON decoding fails.
 */
-func (c *Client) ListLocations(pageURL *string) (LocationAreaList, error) {
-	url := baseURL + "/location-area"
-	if pageURL != nil {
-		url = *pageURL
-	}
-
+func (c *Client) getJSON(url string, v any) error {
 	if val, ok := c.cache.Get(url); ok {
-		locationsResp := LocationAreaList{}
-		err := json.Unmarshal(val, &locationsResp)
-		if err != nil {
-			return LocationAreaList{}, err
-		}
-
-		return locationsResp, nil
+		return json.Unmarshal(val, v)
 	}
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		return LocationAreaList{}, err
+		return err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return LocationAreaList{}, err
+		return err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response from PokéAPI: %s", resp.Status)
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return LocationAreaList{}, err
+		return err
 	}
 
-	locationsResp := LocationAreaList{}
-	err = json.Unmarshal(body, &locationsResp)
+	err = json.Unmarshal(body, v)
 	if err != nil {
-		return LocationAreaList{}, err
+		return err
 	}
 
 	c.cache.Add(url, body)
+	return nil
+}
+
+/*
+ListLocations retrieves a page of location areas from the PokéAPI.
+
+The page URL is built from the offset and limit, so any page can be requested
+directly. If the page is cached, the cached response is returned.
+
+Parameters:
+- offset: The index of the first location area on the page.
+- limit: The number of location areas on the page.
+
+Returns:
+- LocationAreaList: The response containing location areas and the total count.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) ListLocations(offset, limit int) (LocationAreaList, error) {
+	url := fmt.Sprintf("%s/location-area?offset=%d&limit=%d", baseURL, offset, limit)
+
+	locationsResp := LocationAreaList{}
+	if err := c.getJSON(url, &locationsResp); err != nil {
+		return LocationAreaList{}, err
+	}
 	return locationsResp, nil
 }
 
@@ -103,87 +138,329 @@ Returns:
 func (c *Client) GetLocation(locationName string) (LocationArea, error) {
 	url := baseURL + "/location-area/" + locationName
 
-	if val, ok := c.cache.Get(url); ok {
-		locationResp := LocationArea{}
-		err := json.Unmarshal(val, &locationResp)
-		if err != nil {
-			return LocationArea{}, err
-		}
-		return locationResp, nil
+	locationResp := LocationArea{}
+	if err := c.getJSON(url, &locationResp); err != nil {
+		return LocationArea{}, err
 	}
+	return locationResp, nil
+}
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return LocationArea{}, err
+/*
+GetPokemon retrieves details about a specific Pokémon by name.
+
+Parameters:
+- pokemonName: The name of the Pokémon to fetch.
+
+Returns:
+- Pokemon: The response containing Pokémon details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetPokemon(pokemonName string) (Pokemon, error) {
+	url := baseURL + "/pokemon/" + pokemonName
+
+	pokemonResp := Pokemon{}
+	if err := c.getJSON(url, &pokemonResp); err != nil {
+		return Pokemon{}, err
 	}
+	return pokemonResp, nil
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return LocationArea{}, err
+/*
+GetLocationByName retrieves a location, which groups one or more location areas.
+
+Parameters:
+- locationName: The name of the location to fetch, e.g. "sunyshore-city".
+
+Returns:
+- Location: The response containing the location and its areas.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetLocationByName(locationName string) (Location, error) {
+	url := baseURL + "/location/" + locationName
+
+	locationResp := Location{}
+	if err := c.getJSON(url, &locationResp); err != nil {
+		return Location{}, err
 	}
-	defer resp.Body.Close()
+	return locationResp, nil
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return LocationArea{}, err
+/*
+GetRegion retrieves a region and the locations in it.
+
+Parameters:
+- regionName: The name of the region to fetch, e.g. "sinnoh".
+
+Returns:
+- Region: The response containing region details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetRegion(regionName string) (Region, error) {
+	url := baseURL + "/region/" + regionName
+
+	regionResp := Region{}
+	if err := c.getJSON(url, &regionResp); err != nil {
+		return Region{}, err
 	}
+	return regionResp, nil
+}
 
-	locationResp := LocationArea{}
-	err = json.Unmarshal(body, &locationResp)
-	if err != nil {
-		return LocationArea{}, err
+/*
+GetNature retrieves a nature and the stats it raises and lowers.
+
+Parameters:
+- natureName: The name of the nature to fetch, e.g. "adamant".
+
+Returns:
+- Nature: The response containing nature details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetNature(natureName string) (Nature, error) {
+	url := baseURL + "/nature/" + natureName
+
+	natureResp := Nature{}
+	if err := c.getJSON(url, &natureResp); err != nil {
+		return Nature{}, err
 	}
+	return natureResp, nil
+}
 
-	c.cache.Add(url, body)
+/*
+GetPokemonSpecies retrieves the data shared by every form of a Pokemon, such
+as its growth rate and Pokedex numbers.
 
-	return locationResp, nil
+Parameters:
+- speciesName: The name of the species to fetch, e.g. "pikachu".
+
+Returns:
+- PokemonSpecies: The response containing species details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetPokemonSpecies(speciesName string) (PokemonSpecies, error) {
+	url := baseURL + "/pokemon-species/" + speciesName
+
+	speciesResp := PokemonSpecies{}
+	if err := c.getJSON(url, &speciesResp); err != nil {
+		return PokemonSpecies{}, err
+	}
+	return speciesResp, nil
 }
 
 /*
-GetPokemon retrieves details about a specific Pokémon by name.
+GetPokedex retrieves a pokedex and the species listed in it.
 
 Parameters:
-- pokemonName: The name of the Pokémon to fetch.
+- pokedexName: The name of the pokedex to fetch, e.g. "kanto" or "national".
 
 Returns:
-- Pokemon: The response containing Pokémon details.
+- Pokedex: The response containing pokedex details.
 - error: An error if the request or JSON decoding fails.
 */
-func (c *Client) GetPokemon(pokemonName string) (Pokemon, error) {
-	url := baseURL + "/pokemon/" + pokemonName
+func (c *Client) GetPokedex(pokedexName string) (Pokedex, error) {
+	url := baseURL + "/pokedex/" + pokedexName
 
-	if val, ok := c.cache.Get(url); ok {
-		pokemonResp := Pokemon{}
-		err := json.Unmarshal(val, &pokemonResp)
-		if err != nil {
-			return Pokemon{}, err
-		}
-		return pokemonResp, nil
+	pokedexResp := Pokedex{}
+	if err := c.getJSON(url, &pokedexResp); err != nil {
+		return Pokedex{}, err
 	}
+	return pokedexResp, nil
+}
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return Pokemon{}, err
+/*
+GetType retrieves a type and its damage relations with the other types.
+
+Parameters:
+- typeName: The name of the type to fetch, e.g. "water".
+
+Returns:
+- Type: The response containing type details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetType(typeName string) (Type, error) {
+	url := baseURL + "/type/" + typeName
+
+	typeResp := Type{}
+	if err := c.getJSON(url, &typeResp); err != nil {
+		return Type{}, err
 	}
+	return typeResp, nil
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return Pokemon{}, err
+/*
+GetVersion retrieves a game version and the version group it belongs to.
+
+Parameters:
+- versionName: The name of the version to fetch, e.g. "diamond".
+
+Returns:
+- Version: The response containing version details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetVersion(versionName string) (Version, error) {
+	url := baseURL + "/version/" + versionName
+
+	versionResp := Version{}
+	if err := c.getJSON(url, &versionResp); err != nil {
+		return Version{}, err
 	}
-	defer resp.Body.Close()
+	return versionResp, nil
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return Pokemon{}, err
+/*
+GetVersionGroup retrieves a version group with its generation and versions.
+
+Parameters:
+- versionGroupName: The name of the version group to fetch, e.g. "diamond-pearl".
+
+Returns:
+- VersionGroup: The response containing version group details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetVersionGroup(versionGroupName string) (VersionGroup, error) {
+	url := baseURL + "/version-group/" + versionGroupName
+
+	versionGroupResp := VersionGroup{}
+	if err := c.getJSON(url, &versionGroupResp); err != nil {
+		return VersionGroup{}, err
 	}
+	return versionGroupResp, nil
+}
 
-	pokemonResp := Pokemon{}
-	err = json.Unmarshal(body, &pokemonResp)
-	if err != nil {
-		return Pokemon{}, err
+/*
+GetGrowthRate retrieves a growth rate and the experience needed for each level.
+
+Parameters:
+- growthRateName: The name of the growth rate to fetch, e.g. "medium-slow".
+
+Returns:
+- GrowthRate: The response containing growth rate details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetGrowthRate(growthRateName string) (GrowthRate, error) {
+	url := baseURL + "/growth-rate/" + growthRateName
+
+	growthRateResp := GrowthRate{}
+	if err := c.getJSON(url, &growthRateResp); err != nil {
+		return GrowthRate{}, err
 	}
+	return growthRateResp, nil
+}
 
-	c.cache.Add(url, body)
+/*
+GetMove retrieves a move with its power, accuracy, PP and effects.
 
-	return pokemonResp, nil
+Parameters:
+- moveName: The name of the move to fetch, e.g. "thunderbolt".
+
+Returns:
+- Move: The response containing move details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetMove(moveName string) (Move, error) {
+	url := baseURL + "/move/" + moveName
+
+	moveResp := Move{}
+	if err := c.getJSON(url, &moveResp); err != nil {
+		return Move{}, err
+	}
+	return moveResp, nil
+}
+
+/*
+GetAbility retrieves an ability, its effect and the Pokemon that can have it.
+
+Parameters:
+- abilityName: The name of the ability to fetch, e.g. "static".
+
+Returns:
+- Ability: The response containing ability details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetAbility(abilityName string) (Ability, error) {
+	url := baseURL + "/ability/" + abilityName
+
+	abilityResp := Ability{}
+	if err := c.getJSON(url, &abilityResp); err != nil {
+		return Ability{}, err
+	}
+	return abilityResp, nil
+}
+
+/*
+GetItem retrieves an item with its category, cost and effect.
+
+Parameters:
+- itemName: The name of the item to fetch, e.g. "great-ball".
+
+Returns:
+- Item: The response containing item details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetItem(itemName string) (Item, error) {
+	url := baseURL + "/item/" + itemName
+
+	itemResp := Item{}
+	if err := c.getJSON(url, &itemResp); err != nil {
+		return Item{}, err
+	}
+	return itemResp, nil
+}
+
+/*
+GetEvolutionChain retrieves an evolution chain. Chains have no names, so
+they are fetched by the URL found in a Pokemon species.
+
+Parameters:
+- url: The URL of the evolution chain.
+
+Returns:
+- EvolutionChain: The response containing the evolution chain.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetEvolutionChain(url string) (EvolutionChain, error) {
+	chainResp := EvolutionChain{}
+	if err := c.getJSON(url, &chainResp); err != nil {
+		return EvolutionChain{}, err
+	}
+	return chainResp, nil
+}
+
+/*
+GetPokemonEncounters retrieves the location areas where a Pokemon can be
+encountered. They are fetched by the URL found in the Pokemon's
+LocationAreaEncounters.
+
+Parameters:
+- url: The URL of the Pokemon's encounters.
+
+Returns:
+- []LocationAreaEncounter: The areas and the encounters in each game version.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetPokemonEncounters(url string) ([]LocationAreaEncounter, error) {
+	encountersResp := []LocationAreaEncounter{}
+	if err := c.getJSON(url, &encountersResp); err != nil {
+		return nil, err
+	}
+	return encountersResp, nil
+}
+
+/*
+GetBerry retrieves a berry with its growth time, harvest size and flavors.
+
+Parameters:
+- berryName: The name of the berry to fetch, e.g. "oran".
+
+Returns:
+- Berry: The response containing berry details.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetBerry(berryName string) (Berry, error) {
+	url := baseURL + "/berry/" + berryName
+
+	berryResp := Berry{}
+	if err := c.getJSON(url, &berryResp); err != nil {
+		return Berry{}, err
+	}
+	return berryResp, nil
 }
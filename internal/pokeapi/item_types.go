@@ -0,0 +1,25 @@
+package pokeapi
+
+// Item - an object in the player's bag, such as a Poké Ball or a Potion
+type Item struct {
+	ID                int             `json:"id"`
+	Name              string          `json:"name"`
+	Cost              int             `json:"cost"`
+	FlingPower        *int            `json:"fling_power"`
+	FlingEffect       *Resource       `json:"fling_effect"`
+	Attributes        []Resource      `json:"attributes"`
+	Category          Resource        `json:"category"`
+	EffectEntries     []VerboseEffect `json:"effect_entries"`
+	FlavorTextEntries []struct {
+		Text         string   `json:"text"`
+		Language     Resource `json:"language"`
+		VersionGroup Resource `json:"version_group"`
+	} `json:"flavor_text_entries"`
+	Names   []Name `json:"names"`
+	Sprites struct {
+		Default *string `json:"default"`
+	} `json:"sprites"`
+	HeldByPokemon []struct {
+		Pokemon Resource `json:"pokemon"`
+	} `json:"held_by_pokemon"`
+}
@@ -1,15 +1,7 @@
 package pokeapi
 
 // List of location-area
-type LocationAreaList struct {
-	Count    int     `json:"count"`
-	Next     *string `json:"next"`
-	Previous *string `json:"previous"`
-	Results  []struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"results"`
-}
+type LocationAreaList = ResourceList
 
 // Location-area -
 type LocationArea struct {
@@ -33,34 +25,55 @@ type LocationArea struct {
 		Name string `json:"name"`
 		URL  string `json:"url"`
 	} `json:"location"`
-	Names []struct {
-		Name     string `json:"name"`
-		Language struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"language"`
-	} `json:"names"`
+	Names             []Name `json:"names"`
 	PokemonEncounters []struct {
-		Pokemon struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"pokemon"`
-		VersionDetails []struct {
-			Version struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
-			MaxChance        int `json:"max_chance"`
-			EncounterDetails []struct {
-				MinLevel        int           `json:"min_level"`
-				MaxLevel        int           `json:"max_level"`
-				ConditionValues []interface{} `json:"condition_values"`
-				Chance          int           `json:"chance"`
-				Method          struct {
-					Name string `json:"name"`
-					URL  string `json:"url"`
-				} `json:"method"`
-			} `json:"encounter_details"`
-		} `json:"version_details"`
+		Pokemon        Resource                 `json:"pokemon"`
+		VersionDetails []VersionEncounterDetail `json:"version_details"`
 	} `json:"pokemon_encounters"`
 }
+
+// Location - a place in a region, made up of one or more location areas
+type Location struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Region      Resource `json:"region"`
+	Names       []Name   `json:"names"`
+	GameIndices []struct {
+		GameIndex  int      `json:"game_index"`
+		Generation Resource `json:"generation"`
+	} `json:"game_indices"`
+	Areas []Resource `json:"areas"`
+}
+
+// Region - an organized area of the Pokémon world
+type Region struct {
+	ID             int        `json:"id"`
+	Name           string     `json:"name"`
+	Locations      []Resource `json:"locations"`
+	MainGeneration Resource   `json:"main_generation"`
+	Names          []Name     `json:"names"`
+	Pokedexes      []Resource `json:"pokedexes"`
+	VersionGroups  []Resource `json:"version_groups"`
+}
+
+// LocationAreaEncounter - a location area where a Pokémon can be encountered
+type LocationAreaEncounter struct {
+	LocationArea   Resource                 `json:"location_area"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+// VersionEncounterDetail - the encounters of a Pokémon in one game version
+type VersionEncounterDetail struct {
+	Version          Resource    `json:"version"`
+	MaxChance        int         `json:"max_chance"`
+	EncounterDetails []Encounter `json:"encounter_details"`
+}
+
+// Encounter - one way to meet a Pokémon, with its level range and chance
+type Encounter struct {
+	MinLevel        int        `json:"min_level"`
+	MaxLevel        int        `json:"max_level"`
+	ConditionValues []Resource `json:"condition_values"`
+	Chance          int        `json:"chance"`
+	Method          Resource   `json:"method"`
+}
@@ -0,0 +1,50 @@
+package pokeapi
+
+// Move - an attack or other action a Pokémon can use in battle
+type Move struct {
+	ID                int             `json:"id"`
+	Name              string          `json:"name"`
+	Accuracy          *int            `json:"accuracy"`
+	EffectChance      *int            `json:"effect_chance"`
+	PP                *int            `json:"pp"`
+	Priority          int             `json:"priority"`
+	Power             *int            `json:"power"`
+	DamageClass       Resource        `json:"damage_class"`
+	Type              Resource        `json:"type"`
+	Target            Resource        `json:"target"`
+	Generation        Resource        `json:"generation"`
+	EffectEntries     []VerboseEffect `json:"effect_entries"`
+	FlavorTextEntries []FlavorText    `json:"flavor_text_entries"`
+	Meta              *MoveMeta       `json:"meta"`
+	Names             []Name          `json:"names"`
+
+	// PastValues undo the changes made to the move in newer games.
+	PastValues []PastMoveStatValues `json:"past_values"`
+}
+
+// PastMoveStatValues - the values a move had before they changed in a version group
+type PastMoveStatValues struct {
+	Accuracy      *int            `json:"accuracy"`
+	EffectChance  *int            `json:"effect_chance"`
+	Power         *int            `json:"power"`
+	PP            *int            `json:"pp"`
+	EffectEntries []VerboseEffect `json:"effect_entries"`
+	Type          *Resource       `json:"type"`
+	VersionGroup  Resource        `json:"version_group"`
+}
+
+// MoveMeta holds the details of a move's secondary effects.
+type MoveMeta struct {
+	Ailment       Resource `json:"ailment"`
+	Category      Resource `json:"category"`
+	MinHits       *int     `json:"min_hits"`
+	MaxHits       *int     `json:"max_hits"`
+	MinTurns      *int     `json:"min_turns"`
+	MaxTurns      *int     `json:"max_turns"`
+	Drain         int      `json:"drain"`
+	Healing       int      `json:"healing"`
+	CritRate      int      `json:"crit_rate"`
+	AilmentChance int      `json:"ailment_chance"`
+	FlinchChance  int      `json:"flinch_chance"`
+	StatChance    int      `json:"stat_chance"`
+}
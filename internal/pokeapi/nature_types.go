@@ -0,0 +1,12 @@
+package pokeapi
+
+// Nature - influences how a Pokémon's stats grow
+type Nature struct {
+	ID            int       `json:"id"`
+	Name          string    `json:"name"`
+	DecreasedStat *Resource `json:"decreased_stat"`
+	IncreasedStat *Resource `json:"increased_stat"`
+	HatesFlavor   *Resource `json:"hates_flavor"`
+	LikesFlavor   *Resource `json:"likes_flavor"`
+	Names         []Name    `json:"names"`
+}
@@ -1,5 +1,13 @@
 package pokeapi
 
+import "errors"
+
+// ErrNotFound is returned when the PokéAPI has no resource with the requested name.
+var ErrNotFound = errors.New("not found")
+
 const (
 	baseURL = "https://pokeapi.co/api/v2"
+
+	// DefaultPageSize is the number of results per page of a list endpoint.
+	DefaultPageSize = 20
 )
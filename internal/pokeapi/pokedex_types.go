@@ -0,0 +1,18 @@
+package pokeapi
+
+// Pokedex - a list of Pokémon species, for the whole world or one region
+type Pokedex struct {
+	ID             int            `json:"id"`
+	Name           string         `json:"name"`
+	IsMainSeries   bool           `json:"is_main_series"`
+	Region         *Resource      `json:"region"`
+	Names          []Name         `json:"names"`
+	PokemonEntries []PokedexEntry `json:"pokemon_entries"`
+	VersionGroups  []Resource     `json:"version_groups"`
+}
+
+// PokedexEntry - a species and its number in a pokedex
+type PokedexEntry struct {
+	EntryNumber    int      `json:"entry_number"`
+	PokemonSpecies Resource `json:"pokemon_species"`
+}
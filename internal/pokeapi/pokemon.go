@@ -63,7 +63,8 @@ type Pokemon struct {
 		Name string `json:"name"`
 		URL  string `json:"url"`
 	} `json:"species"`
-	Cries struct {
+	Sprites Sprites `json:"sprites"`
+	Cries   struct {
 		Latest string `json:"latest"`
 		Legacy string `json:"legacy"`
 	} `json:"cries"`
@@ -96,3 +97,31 @@ type Pokemon struct {
 		} `json:"types"`
 	} `json:"past_types"`
 }
+
+// Sprites holds the URLs of a Pokemon's images. Missing images are nil.
+type Sprites struct {
+	BackDefault      *string `json:"back_default"`
+	BackFemale       *string `json:"back_female"`
+	BackShiny        *string `json:"back_shiny"`
+	BackShinyFemale  *string `json:"back_shiny_female"`
+	FrontDefault     *string `json:"front_default"`
+	FrontFemale      *string `json:"front_female"`
+	FrontShiny       *string `json:"front_shiny"`
+	FrontShinyFemale *string `json:"front_shiny_female"`
+	Other            struct {
+		DreamWorld struct {
+			FrontDefault *string `json:"front_default"`
+			FrontFemale  *string `json:"front_female"`
+		} `json:"dream_world"`
+		Home struct {
+			FrontDefault     *string `json:"front_default"`
+			FrontFemale      *string `json:"front_female"`
+			FrontShiny       *string `json:"front_shiny"`
+			FrontShinyFemale *string `json:"front_shiny_female"`
+		} `json:"home"`
+		OfficialArtwork struct {
+			FrontDefault *string `json:"front_default"`
+			FrontShiny   *string `json:"front_shiny"`
+		} `json:"official-artwork"`
+	} `json:"other"`
+}
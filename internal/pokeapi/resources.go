@@ -0,0 +1,236 @@
+package pokeapi
+
+import (
+	"fmt"
+	"iter"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Endpoints that return lists of named resources.
+const (
+	EndpointAbility        = "ability"
+	EndpointBerry          = "berry"
+	EndpointItem           = "item"
+	EndpointLanguage       = "language"
+	EndpointLocation       = "location"
+	EndpointLocationArea   = "location-area"
+	EndpointMove           = "move"
+	EndpointNature         = "nature"
+	EndpointPokemon        = "pokemon"
+	EndpointPokedex        = "pokedex"
+	EndpointPokemonSpecies = "pokemon-species"
+	EndpointRegion         = "region"
+	EndpointType           = "type"
+	EndpointVersion        = "version"
+	EndpointVersionGroup   = "version-group"
+)
+
+// Resource is a reference to a PokéAPI resource by name and URL.
+type Resource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Name is the name of a resource in one language.
+type Name struct {
+	Name     string   `json:"name"`
+	Language Resource `json:"language"`
+}
+
+// ResourceID returns the ID at the end of a resource URL, e.g. 25 for
+// ".../pokemon/25/", or 0 if the URL doesn't end with one.
+func ResourceID(url string) int {
+	id, err := strconv.Atoi(path.Base(strings.TrimSuffix(url, "/")))
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// ResourceList is one page of a named-resource list endpoint.
+type ResourceList struct {
+	Count    int        `json:"count"`
+	Next     *string    `json:"next"`
+	Previous *string    `json:"previous"`
+	Results  []Resource `json:"results"`
+}
+
+/*
+ListResources retrieves one page of any named-resource list endpoint.
+
+Parameters:
+- endpoint: The endpoint name, e.g. EndpointPokemon.
+- offset: The index of the first resource on the page.
+- limit: The number of resources on the page.
+
+Returns:
+- ResourceList: The page of resources and the total count.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) ListResources(endpoint string, offset, limit int) (ResourceList, error) {
+	url := fmt.Sprintf("%s/%s?offset=%d&limit=%d", baseURL, endpoint, offset, limit)
+
+	listResp := ResourceList{}
+	if err := c.getJSON(url, &listResp); err != nil {
+		return ResourceList{}, err
+	}
+	return listResp, nil
+}
+
+/*
+GetNames retrieves the names of any named resource in every language it has
+been translated to.
+
+Parameters:
+- endpoint: The endpoint name, e.g. EndpointMove.
+- name: The name of the resource, e.g. "thunderbolt".
+
+Returns:
+- []Name: The localized names of the resource.
+- error: An error if the request or JSON decoding fails.
+*/
+func (c *Client) GetNames(endpoint, name string) ([]Name, error) {
+	url := baseURL + "/" + endpoint + "/" + name
+
+	namesResp := struct {
+		Names []Name `json:"names"`
+	}{}
+	if err := c.getJSON(url, &namesResp); err != nil {
+		return nil, err
+	}
+	return namesResp.Names, nil
+}
+
+// IterOptions controls how All walks a list endpoint.
+type IterOptions struct {
+	// PageSize is the number of resources per request. Defaults to DefaultPageSize.
+	PageSize int
+	// Concurrency is the number of pages fetched in parallel. Defaults to 1.
+	Concurrency int
+	// Prefetch fetches the next page while the current one is being consumed.
+	Prefetch bool
+}
+
+// pageResult is a fetched page, or the error that prevented fetching it.
+type pageResult struct {
+	list ResourceList
+	err  error
+}
+
+/*
+All returns an iterator over every resource of a list endpoint.
+
+Pages are fetched lazily as the iterator is consumed and resources are yielded
+in API order. With Concurrency above 1 or Prefetch set, upcoming pages are
+fetched in the background, but never more than Concurrency pages ahead of the
+consumer. Stopping the iteration early stops fetching new pages.
+
+If a page can't be fetched, the error is yielded and the iteration ends.
+
+Parameters:
+- endpoint: The endpoint name, e.g. EndpointLocationArea.
+- opts: Paging and concurrency options.
+
+Returns:
+- iter.Seq2[Resource, error]: The resources of the endpoint.
+*/
+func (c *Client) All(endpoint string, opts IterOptions) iter.Seq2[Resource, error] {
+	pageSize := opts.PageSize
+	if pageSize < 1 {
+		pageSize = DefaultPageSize
+	}
+	workers := max(1, opts.Concurrency)
+
+	return func(yield func(Resource, error) bool) {
+		// The first page tells how many pages there are.
+		first, err := c.ListResources(endpoint, 0, pageSize)
+		if err != nil {
+			yield(Resource{}, err)
+			return
+		}
+
+		offsets := []int{}
+		for offset := pageSize; offset < first.Count; offset += pageSize {
+			offsets = append(offsets, offset)
+		}
+
+		fetch := func(offset int) pageResult {
+			list, err := c.ListResources(endpoint, offset, pageSize)
+			return pageResult{list: list, err: err}
+		}
+		next := func(i int) pageResult {
+			return fetch(offsets[i])
+		}
+		if workers > 1 || opts.Prefetch {
+			var stop func()
+			next, stop = prefetchPages(offsets, workers, fetch)
+			defer stop()
+		}
+
+		if !yieldPage(first, yield) {
+			return
+		}
+		for i := range offsets {
+			page := next(i)
+			if page.err != nil {
+				yield(Resource{}, page.err)
+				return
+			}
+			if !yieldPage(page.list, yield) {
+				return
+			}
+		}
+	}
+}
+
+/*
+prefetchPages starts fetching pages in the background, keeping at most
+workers pages in flight or waiting to be consumed.
+
+Returns a function that waits for the i-th page, and a function that stops
+scheduling new fetches. Pages must be requested in order.
+*/
+func prefetchPages(offsets []int, workers int, fetch func(int) pageResult) (func(int) pageResult, func()) {
+	results := make([]chan pageResult, len(offsets))
+	for i := range results {
+		// Buffered, so fetches finishing after a stop don't block.
+		results[i] = make(chan pageResult, 1)
+	}
+	slots := make(chan struct{}, workers)
+	done := make(chan struct{})
+
+	go func() {
+		for i, offset := range offsets {
+			select {
+			case slots <- struct{}{}:
+			case <-done:
+				return
+			}
+			go func() {
+				results[i] <- fetch(offset)
+			}()
+		}
+	}()
+
+	next := func(i int) pageResult {
+		page := <-results[i]
+		<-slots
+		return page
+	}
+	stop := func() {
+		close(done)
+	}
+	return next, stop
+}
+
+// yieldPage yields the resources of a page, reporting whether to continue.
+func yieldPage(list ResourceList, yield func(Resource, error) bool) bool {
+	for _, resource := range list.Results {
+		if !yield(resource, nil) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeList serves a list endpoint with count resources named "res-<n>".
+type fakeList struct {
+	count    int
+	requests atomic.Int32
+}
+
+func (f *fakeList) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.requests.Add(1)
+	offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+	limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+
+	list := ResourceList{Count: f.count, Results: []Resource{}}
+	for i := offset; i < min(offset+limit, f.count); i++ {
+		list.Results = append(list.Results, Resource{Name: fmt.Sprintf("res-%d", i)})
+	}
+	body, _ := json.Marshal(list)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(string(body))),
+		Request:    req,
+	}, nil
+}
+
+func newFakeClient(transport http.RoundTripper) Client {
+	client := NewClient(time.Second, time.Minute, "")
+	client.httpClient.Transport = transport
+	return client
+}
+
+func TestAll(t *testing.T) {
+	cases := []IterOptions{
+		{PageSize: 3},
+		{PageSize: 3, Prefetch: true},
+		{PageSize: 2, Concurrency: 4},
+		{PageSize: 100},
+	}
+
+	for i, opts := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			client := newFakeClient(&fakeList{count: 10})
+			n := 0
+			for resource, err := range client.All(EndpointPokemon, opts) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if expected := fmt.Sprintf("res-%d", n); resource.Name != expected {
+					t.Fatalf("expected %s, got %s", expected, resource.Name)
+				}
+				n++
+			}
+			if n != 10 {
+				t.Errorf("expected 10 resources, got %d", n)
+			}
+		})
+	}
+}
+
+func TestAllStopsEarly(t *testing.T) {
+	fake := &fakeList{count: 100}
+	client := newFakeClient(fake)
+	for resource := range client.All(EndpointPokemon, IterOptions{PageSize: 5}) {
+		if resource.Name == "res-6" {
+			break
+		}
+	}
+	if n := fake.requests.Load(); n != 2 {
+		t.Errorf("expected 2 page requests, got %d", n)
+	}
+}
+
+func TestResourceID(t *testing.T) {
+	cases := map[string]int{
+		"https://pokeapi.co/api/v2/pokemon/25/":         25,
+		"https://pokeapi.co/api/v2/pokemon-species/151": 151,
+		"https://pokeapi.co/api/v2/pokemon/pikachu/":    0,
+		"": 0,
+	}
+	for url, want := range cases {
+		if got := ResourceID(url); got != want {
+			t.Errorf("ResourceID(%q) = %d, want %d", url, got, want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package pokeapi
+
+// Pokemon-species - the data shared by every form of a Pokémon
+type PokemonSpecies struct {
+	ID                   int       `json:"id"`
+	Name                 string    `json:"name"`
+	Order                int       `json:"order"`
+	GenderRate           int       `json:"gender_rate"`
+	CaptureRate          int       `json:"capture_rate"`
+	BaseHappiness        int       `json:"base_happiness"`
+	IsBaby               bool      `json:"is_baby"`
+	IsLegendary          bool      `json:"is_legendary"`
+	IsMythical           bool      `json:"is_mythical"`
+	HatchCounter         int       `json:"hatch_counter"`
+	HasGenderDifferences bool      `json:"has_gender_differences"`
+	FormsSwitchable      bool      `json:"forms_switchable"`
+	GrowthRate           Resource  `json:"growth_rate"`
+	EvolvesFromSpecies   *Resource `json:"evolves_from_species"`
+	EvolutionChain       struct {
+		URL string `json:"url"`
+	} `json:"evolution_chain"`
+	Generation     Resource   `json:"generation"`
+	EggGroups      []Resource `json:"egg_groups"`
+	Color          Resource   `json:"color"`
+	Shape          *Resource  `json:"shape"`
+	Habitat        *Resource  `json:"habitat"`
+	PokedexNumbers []struct {
+		EntryNumber int      `json:"entry_number"`
+		Pokedex     Resource `json:"pokedex"`
+	} `json:"pokedex_numbers"`
+	Names  []Name `json:"names"`
+	Genera []struct {
+		Genus    string   `json:"genus"`
+		Language Resource `json:"language"`
+	} `json:"genera"`
+	FlavorTextEntries []FlavorText `json:"flavor_text_entries"`
+	Varieties         []struct {
+		IsDefault bool     `json:"is_default"`
+		Pokemon   Resource `json:"pokemon"`
+	} `json:"varieties"`
+}
+
+// Growth-rate - how much experience a Pokémon needs to level up
+type GrowthRate struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Formula      string `json:"formula"`
+	Descriptions []struct {
+		Description string   `json:"description"`
+		Language    Resource `json:"language"`
+	} `json:"descriptions"`
+	Levels []struct {
+		Level      int `json:"level"`
+		Experience int `json:"experience"`
+	} `json:"levels"`
+	PokemonSpecies []Resource `json:"pokemon_species"`
+}
+
+// Evolution-chain - the family tree of Pokémon species that evolve into each other
+type EvolutionChain struct {
+	ID              int       `json:"id"`
+	BabyTriggerItem *Resource `json:"baby_trigger_item"`
+	Chain           ChainLink `json:"chain"`
+}
+
+// ChainLink is a species in an evolution chain and the species it evolves into.
+type ChainLink struct {
+	IsBaby           bool              `json:"is_baby"`
+	Species          Resource          `json:"species"`
+	EvolutionDetails []EvolutionDetail `json:"evolution_details"`
+	EvolvesTo        []ChainLink       `json:"evolves_to"`
+}
+
+// EvolutionDetail is one way a species evolves from the previous one in its chain.
+type EvolutionDetail struct {
+	Item                  *Resource `json:"item"`
+	Trigger               Resource  `json:"trigger"`
+	Gender                *int      `json:"gender"`
+	HeldItem              *Resource `json:"held_item"`
+	KnownMove             *Resource `json:"known_move"`
+	KnownMoveType         *Resource `json:"known_move_type"`
+	Location              *Resource `json:"location"`
+	MinLevel              *int      `json:"min_level"`
+	MinHappiness          *int      `json:"min_happiness"`
+	MinBeauty             *int      `json:"min_beauty"`
+	MinAffection          *int      `json:"min_affection"`
+	NeedsOverworldRain    bool      `json:"needs_overworld_rain"`
+	PartySpecies          *Resource `json:"party_species"`
+	PartyType             *Resource `json:"party_type"`
+	RelativePhysicalStats *int      `json:"relative_physical_stats"`
+	TimeOfDay             string    `json:"time_of_day"`
+	TradeSpecies          *Resource `json:"trade_species"`
+	TurnUpsideDown        bool      `json:"turn_upside_down"`
+}
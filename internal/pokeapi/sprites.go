@@ -0,0 +1,56 @@
+package pokeapi
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+/*
+GetSprite downloads a sprite image.
+
+The image is looked up in the in-memory cache first, then in the on-disk
+sprite cache, so sprites that were downloaded once are available offline.
+
+Parameters:
+- spriteURL: The image URL, e.g. Pokemon.Sprites.FrontDefault.
+
+Returns:
+- []byte: The image data, usually a PNG.
+- error: An error if the image can't be downloaded.
+*/
+func (c *Client) GetSprite(spriteURL string) ([]byte, error) {
+	if val, ok := c.cache.Get(spriteURL); ok {
+		return val, nil
+	}
+	if val, ok := c.spriteCache.Get(spriteURL); ok {
+		c.cache.Add(spriteURL, val)
+		return val, nil
+	}
+
+	req, err := http.NewRequest("GET", spriteURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("couldn't download sprite: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.Add(spriteURL, body)
+	// Failing to write the disk cache only costs offline access, so the
+	// downloaded image is still returned.
+	_ = c.spriteCache.Add(spriteURL, body)
+	return body, nil
+}
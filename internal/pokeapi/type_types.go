@@ -0,0 +1,30 @@
+package pokeapi
+
+// Type - an elemental type, and how much damage it deals to and takes from other types
+type Type struct {
+	ID              int             `json:"id"`
+	Name            string          `json:"name"`
+	DamageRelations DamageRelations `json:"damage_relations"`
+	Generation      Resource        `json:"generation"`
+	MoveDamageClass *Resource       `json:"move_damage_class"`
+	Names           []Name          `json:"names"`
+	Pokemon         []struct {
+		Slot    int      `json:"slot"`
+		Pokemon Resource `json:"pokemon"`
+	} `json:"pokemon"`
+	Moves               []Resource `json:"moves"`
+	PastDamageRelations []struct {
+		Generation      Resource        `json:"generation"`
+		DamageRelations DamageRelations `json:"damage_relations"`
+	} `json:"past_damage_relations"`
+}
+
+// DamageRelations - the types a type is strong or weak against
+type DamageRelations struct {
+	NoDamageTo       []Resource `json:"no_damage_to"`
+	HalfDamageTo     []Resource `json:"half_damage_to"`
+	DoubleDamageTo   []Resource `json:"double_damage_to"`
+	NoDamageFrom     []Resource `json:"no_damage_from"`
+	HalfDamageFrom   []Resource `json:"half_damage_from"`
+	DoubleDamageFrom []Resource `json:"double_damage_from"`
+}
@@ -0,0 +1,21 @@
+package pokeapi
+
+// Version - a single game, e.g. "diamond"
+type Version struct {
+	ID           int      `json:"id"`
+	Name         string   `json:"name"`
+	Names        []Name   `json:"names"`
+	VersionGroup Resource `json:"version_group"`
+}
+
+// Version-group - games released together that share their data, e.g. "diamond-pearl"
+type VersionGroup struct {
+	ID               int        `json:"id"`
+	Name             string     `json:"name"`
+	Order            int        `json:"order"`
+	Generation       Resource   `json:"generation"`
+	MoveLearnMethods []Resource `json:"move_learn_methods"`
+	Pokedexes        []Resource `json:"pokedexes"`
+	Regions          []Resource `json:"regions"`
+	Versions         []Resource `json:"versions"`
+}
@@ -0,0 +1,75 @@
+package pokecache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+)
+
+// DiskCache stores key-value pairs as files in a directory so they survive
+// between runs. Entries never expire. The zero value stores nothing.
+type DiskCache struct {
+	dir string
+}
+
+/*
+NewDiskCache creates a DiskCache that keeps its files in dir.
+The directory is created on the first Add.
+
+Parameters:
+- dir: The directory to store entries in.
+
+Returns:
+- DiskCache: A new DiskCache instance.
+*/
+func NewDiskCache(dir string) DiskCache {
+	return DiskCache{dir: dir}
+}
+
+/*
+Add writes a key-value pair to disk, replacing any previous value.
+
+Parameters:
+- key: A string representing the cache key.
+- val: A byte slice containing the data to be stored.
+
+Returns:
+- error: An error if the directory or file can't be written.
+*/
+func (c *DiskCache) Add(key string, val []byte) error {
+	if c.dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path(key), val, 0o644)
+}
+
+/*
+Get reads a value from disk based on the given key.
+
+Parameters:
+- key: A string representing the cache key.
+
+Returns:
+- []byte: The cached data.
+- bool: True if the key exists, false otherwise.
+*/
+func (c *DiskCache) Get(key string) ([]byte, bool) {
+	if c.dir == "" {
+		return nil, false
+	}
+	val, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return val, true
+}
+
+// path returns the file that holds the entry for key.
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
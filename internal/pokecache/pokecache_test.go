@@ -59,3 +59,29 @@ func TestReapLoop(t *testing.T) {
 		return
 	}
 }
+
+func TestDiskCache(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewDiskCache(dir)
+	if _, ok := cache.Get("https://example.com/sprite.png"); ok {
+		t.Errorf("expected to not find key")
+		return
+	}
+
+	if err := cache.Add("https://example.com/sprite.png", []byte("pngdata")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	// A new cache on the same directory sees entries from earlier runs.
+	reopened := NewDiskCache(dir)
+	val, ok := reopened.Get("https://example.com/sprite.png")
+	if !ok {
+		t.Errorf("expected to find key")
+		return
+	}
+	if string(val) != "pngdata" {
+		t.Errorf("expected to find value")
+		return
+	}
+}
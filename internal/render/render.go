@@ -0,0 +1,259 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Renderer draws tables, badges and bars for terminal output.
+// When Color is false all output is plain text.
+type Renderer struct {
+	out   io.Writer
+	Color bool
+}
+
+/*
+New creates a Renderer writing to the given file.
+
+Color is enabled only when the file is a terminal and the NO_COLOR
+environment variable is not set to a non-empty value (see https://no-color.org).
+
+Parameters:
+- f: The file to write to, usually os.Stdout.
+
+Returns:
+- *Renderer: A new renderer.
+*/
+func New(f *os.File) *Renderer {
+	return &Renderer{
+		out:   f,
+		Color: IsTerminal(f) && !noColor(),
+	}
+}
+
+// noColor reports whether the user asked for no color. An empty NO_COLOR doesn't count.
+func noColor() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
+
+// IsTerminal reports whether the file is attached to a terminal.
+func IsTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// RGB is a 24-bit terminal color.
+type RGB struct {
+	R, G, B uint8
+}
+
+// Some commonly used colors.
+var (
+	Red    = RGB{220, 50, 47}
+	Yellow = RGB{230, 190, 40}
+	Green  = RGB{90, 190, 80}
+	Cyan   = RGB{40, 180, 200}
+	Gray   = RGB{128, 128, 128}
+)
+
+// typeColors maps Pokemon type names to their conventional colors.
+var typeColors = map[string]RGB{
+	"normal":   {168, 167, 122},
+	"fire":     {238, 129, 48},
+	"water":    {99, 144, 240},
+	"electric": {247, 208, 44},
+	"grass":    {122, 199, 76},
+	"ice":      {150, 217, 214},
+	"fighting": {194, 46, 40},
+	"poison":   {163, 62, 161},
+	"ground":   {226, 191, 101},
+	"flying":   {169, 143, 243},
+	"psychic":  {249, 85, 135},
+	"bug":      {166, 185, 26},
+	"rock":     {182, 161, 54},
+	"ghost":    {115, 87, 151},
+	"dragon":   {111, 53, 252},
+	"dark":     {112, 87, 70},
+	"steel":    {183, 183, 206},
+	"fairy":    {214, 133, 173},
+}
+
+// Colorize wraps s in the escape codes for the given foreground color.
+func (r *Renderer) Colorize(c RGB, s string) string {
+	if !r.Color {
+		return s
+	}
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm%s\x1b[0m", c.R, c.G, c.B, s)
+}
+
+// Bold makes s bold.
+func (r *Renderer) Bold(s string) string {
+	if !r.Color {
+		return s
+	}
+	return "\x1b[1m" + s + "\x1b[0m"
+}
+
+/*
+TypeBadge formats a Pokemon type name as a colored badge, e.g. " FIRE " on a
+red background. Without color the badge is written as [fire].
+*/
+func (r *Renderer) TypeBadge(typeName string) string {
+	return r.LabeledTypeBadge(typeName, typeName)
+}
+
+// LabeledTypeBadge formats a badge colored for a type but showing another
+// label, such as the type's name in another language.
+func (r *Renderer) LabeledTypeBadge(typeName, label string) string {
+	c, ok := typeColors[typeName]
+	if !r.Color {
+		return "[" + label + "]"
+	}
+	if !ok {
+		c = Gray
+	}
+	return fmt.Sprintf("\x1b[1;38;2;255;255;255;48;2;%d;%d;%dm %s \x1b[0m", c.R, c.G, c.B, strings.ToUpper(label))
+}
+
+// TypeBadges formats several type names as badges separated by spaces.
+func (r *Renderer) TypeBadges(typeNames []string) string {
+	badges := []string{}
+	for _, name := range typeNames {
+		badges = append(badges, r.TypeBadge(name))
+	}
+	return strings.Join(badges, " ")
+}
+
+/*
+StatBar draws a horizontal bar for a stat value.
+
+Parameters:
+- value: The stat value.
+- maxValue: The value that fills the whole bar.
+- width: The bar width in characters.
+
+Returns:
+- string: The bar, colored from red (low) to cyan (high) when color is enabled.
+*/
+func (r *Renderer) StatBar(value, maxValue, width int) string {
+	filled := 0
+	if maxValue > 0 {
+		filled = max(0, min(width, (value*width+maxValue/2)/maxValue))
+	}
+	if !r.Color {
+		return strings.Repeat("#", filled) + strings.Repeat(".", width-filled)
+	}
+	return r.Colorize(statColor(value), strings.Repeat("█", filled)) + r.Colorize(Gray, strings.Repeat("░", width-filled))
+}
+
+// statColor picks a bar color for a base stat value.
+func statColor(value int) RGB {
+	switch {
+	case value < 50:
+		return Red
+	case value < 80:
+		return Yellow
+	case value < 110:
+		return Green
+	default:
+		return Cyan
+	}
+}
+
+/*
+Table writes rows as aligned columns under a header line.
+Cells may contain escape codes; they don't count towards column widths.
+
+Parameters:
+- headers: The column titles.
+- rows: The table cells, one slice per row.
+*/
+func (r *Renderer) Table(headers []string, rows [][]string) {
+	widths := make([]int, len(headers))
+	for i, h := range headers {
+		widths[i] = VisibleWidth(h)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if i < len(widths) {
+				widths[i] = max(widths[i], VisibleWidth(cell))
+			}
+		}
+	}
+
+	titles := make([]string, len(headers))
+	rules := make([]string, len(headers))
+	for i, h := range headers {
+		titles[i] = r.Bold(h)
+		rules[i] = strings.Repeat("─", widths[i])
+	}
+	r.writeRow(titles, widths)
+	r.writeRow(rules, widths)
+	for _, row := range rows {
+		r.writeRow(row, widths)
+	}
+}
+
+func (r *Renderer) writeRow(cells []string, widths []int) {
+	var sb strings.Builder
+	for i, cell := range cells {
+		if i >= len(widths) {
+			break
+		}
+		sb.WriteString(cell)
+		if i < len(cells)-1 {
+			sb.WriteString(strings.Repeat(" ", widths[i]-VisibleWidth(cell)+2))
+		}
+	}
+	fmt.Fprintln(r.out, strings.TrimRight(sb.String(), " "))
+}
+
+var escapeCodes = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// wideRunes are the East Asian wide and fullwidth characters, which take up
+// two columns in a terminal: Hangul, kana, CJK ideographs, fullwidth forms and
+// emoji.
+var wideRunes = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115f, Stride: 1},
+		{Lo: 0x2e80, Hi: 0x303e, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33ff, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4dbf, Stride: 1},
+		{Lo: 0x4e00, Hi: 0x9fff, Stride: 1},
+		{Lo: 0xa000, Hi: 0xa4cf, Stride: 1},
+		{Lo: 0xa960, Hi: 0xa97f, Stride: 1},
+		{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+		{Lo: 0xf900, Hi: 0xfaff, Stride: 1},
+		{Lo: 0xfe10, Hi: 0xfe19, Stride: 1},
+		{Lo: 0xfe30, Hi: 0xfe6f, Stride: 1},
+		{Lo: 0xff00, Hi: 0xff60, Stride: 1},
+		{Lo: 0xffe0, Hi: 0xffe6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f300, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f900, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+	},
+}
+
+// VisibleWidth returns the number of columns s takes up on screen, ignoring
+// color codes. Wide characters such as kana and CJK ideographs take two.
+func VisibleWidth(s string) int {
+	width := 0
+	for _, r := range escapeCodes.ReplaceAllString(s, "") {
+		if unicode.Is(wideRunes, r) {
+			width += 2
+		} else {
+			width++
+		}
+	}
+	return width
+}
@@ -0,0 +1,119 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+)
+
+func TestTable(t *testing.T) {
+	var sb strings.Builder
+	r := &Renderer{out: &sb}
+	r.Table([]string{"ID", "Name"}, [][]string{
+		{"25", "pikachu"},
+		{"122", "mr-mime"},
+	})
+
+	expected := `ID   Name
+───  ───────
+25   pikachu
+122  mr-mime
+`
+	if sb.String() != expected {
+		t.Errorf("Expecting:\n%s\nActual:\n%s", expected, sb.String())
+	}
+}
+
+func TestTableWideCharacters(t *testing.T) {
+	var sb strings.Builder
+	r := &Renderer{out: &sb}
+	r.Table([]string{"Name", "Lv"}, [][]string{
+		{"ピカチュウ", "5"},
+		{"pikachu", "12"},
+	})
+
+	expected := `Name        Lv
+──────────  ──
+ピカチュウ  5
+pikachu     12
+`
+	if sb.String() != expected {
+		t.Errorf("Expecting:\n%s\nActual:\n%s", expected, sb.String())
+	}
+}
+
+func TestVisibleWidth(t *testing.T) {
+	r := &Renderer{Color: true}
+	cases := map[string]int{
+		"pikachu":                       7,
+		r.TypeBadge("fire"):             6,
+		r.StatBar(45, 255, 10):          10,
+		r.Colorize(Red, "█░"):           2,
+		(&Renderer{}).TypeBadge("fire"): 6,
+		"Pokémon":                       7,
+		"ピカチュウ":                         10,
+		"피카츄":                           6,
+		"皮卡丘":                           6,
+		"ＰＩＫＡ":                          8,
+		"ｶﾞ":                            2,
+		r.Colorize(Yellow, "ピカチュウ"):     10,
+	}
+	for input, expected := range cases {
+		if actual := VisibleWidth(input); actual != expected {
+			t.Errorf("VisibleWidth(%q) = %d, expected %d", input, actual, expected)
+		}
+	}
+}
+
+func TestNoColor(t *testing.T) {
+	cases := map[string]bool{"": false, "1": true, "false": true}
+	for value, expected := range cases {
+		t.Setenv("NO_COLOR", value)
+		if actual := noColor(); actual != expected {
+			t.Errorf("NO_COLOR=%q: noColor() = %v, expected %v", value, actual, expected)
+		}
+	}
+}
+
+func TestStatBar(t *testing.T) {
+	r := &Renderer{}
+	cases := []struct {
+		value    int
+		expected string
+	}{
+		{0, ".........."},
+		{50, "#####....."},
+		{100, "##########"},
+		{180, "##########"},
+	}
+	for _, c := range cases {
+		if actual := r.StatBar(c.value, 100, 10); actual != c.expected {
+			t.Errorf("StatBar(%d) = %q, expected %q", c.value, actual, c.expected)
+		}
+	}
+}
+
+func TestHalfBlocks(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(1, 1, color.NRGBA{255, 0, 0, 255})
+	img.Set(2, 1, color.NRGBA{0, 0, 255, 255})
+	img.Set(2, 2, color.NRGBA{0, 255, 0, 255})
+
+	expected := "\x1b[0;38;2;255;0;0m▀\x1b[38;2;0;0;255;48;2;0;255;0m▀\x1b[0m\n"
+	actual := HalfBlocks(crop(img))
+	if actual != expected {
+		t.Errorf("Expecting: %q\nActual:    %q", expected, actual)
+	}
+}
+
+func TestSixel(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.NRGBA{255, 0, 0, 255})
+
+	expected := "\x1bP0;1;0q\"1;1;2;1#180;2;100;0;0#180@?-\x1b\\\n"
+	actual := Sixel(img, 1)
+	if actual != expected {
+		t.Errorf("Expecting: %q\nActual:    %q", expected, actual)
+	}
+}
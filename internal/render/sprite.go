@@ -0,0 +1,232 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/png"
+	"os"
+	"strings"
+)
+
+// opaque is the alpha value above which a pixel is drawn.
+const opaque = 0x8000
+
+/*
+Sprite draws an image in the terminal.
+
+Sixel graphics are used when the terminal supports them, otherwise the image
+is drawn with colored half-block characters, two pixels per character cell.
+Transparent borders are cropped. Nothing is drawn when color is disabled.
+
+Parameters:
+- data: The encoded image, e.g. a PNG sprite.
+
+Returns:
+- error: An error if the image can't be decoded.
+*/
+func (r *Renderer) Sprite(data []byte) error {
+	if !r.Color {
+		return nil
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	img = crop(img)
+
+	if SixelSupported() {
+		_, err = fmt.Fprint(r.out, Sixel(img, 2))
+	} else {
+		_, err = fmt.Fprint(r.out, HalfBlocks(img))
+	}
+	return err
+}
+
+/*
+SixelSupported guesses whether the terminal can show Sixel graphics from the
+environment. Setting POKEDEX_SIXEL=1 or POKEDEX_SIXEL=0 overrides the guess.
+*/
+func SixelSupported() bool {
+	switch os.Getenv("POKEDEX_SIXEL") {
+	case "1":
+		return true
+	case "0":
+		return false
+	}
+	term := os.Getenv("TERM")
+	if strings.Contains(term, "sixel") || term == "foot" || term == "mlterm" || strings.HasPrefix(term, "yaft") {
+		return true
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "WezTerm", "iTerm.app", "mintty":
+		return true
+	}
+	return false
+}
+
+// crop returns the smallest part of img that contains all opaque pixels.
+func crop(img image.Image) image.Image {
+	b := img.Bounds()
+	box := image.Rectangle{}
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a >= opaque {
+				box = box.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	if box.Empty() {
+		return img
+	}
+	if sub, ok := img.(interface {
+		SubImage(image.Rectangle) image.Image
+	}); ok {
+		return sub.SubImage(box)
+	}
+	return img
+}
+
+/*
+HalfBlocks draws an image with "▀" characters using 24-bit colors: the
+foreground paints the upper pixel and the background the lower one.
+
+Parameters:
+- img: The image to draw.
+
+Returns:
+- string: The escape sequences and characters, one line per two pixel rows.
+*/
+func HalfBlocks(img image.Image) string {
+	var sb strings.Builder
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y += 2 {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			top, topOK := pixel(img, x, y)
+			bottom, bottomOK := RGB{}, false
+			if y+1 < b.Max.Y {
+				bottom, bottomOK = pixel(img, x, y+1)
+			}
+			switch {
+			case topOK && bottomOK:
+				fmt.Fprintf(&sb, "\x1b[38;2;%d;%d;%d;48;2;%d;%d;%dm▀", top.R, top.G, top.B, bottom.R, bottom.G, bottom.B)
+			case topOK:
+				fmt.Fprintf(&sb, "\x1b[0;38;2;%d;%d;%dm▀", top.R, top.G, top.B)
+			case bottomOK:
+				fmt.Fprintf(&sb, "\x1b[0;38;2;%d;%d;%dm▄", bottom.R, bottom.G, bottom.B)
+			default:
+				sb.WriteString("\x1b[0m ")
+			}
+		}
+		sb.WriteString("\x1b[0m\n")
+	}
+	return sb.String()
+}
+
+// pixel returns the 8-bit color at (x, y) and whether it is opaque.
+func pixel(img image.Image, x, y int) (RGB, bool) {
+	r, g, b, a := img.At(x, y).RGBA()
+	if a < opaque {
+		return RGB{}, false
+	}
+	// Undo the alpha premultiplication of RGBA.
+	return RGB{uint8(r * 0xffff / a >> 8), uint8(g * 0xffff / a >> 8), uint8(b * 0xffff / a >> 8)}, true
+}
+
+/*
+Sixel encodes an image as a Sixel graphic using a 6x6x6 color cube.
+Transparent pixels are left unpainted.
+
+Parameters:
+- img: The image to draw.
+- scale: How many screen pixels to use for each image pixel.
+
+Returns:
+- string: The Sixel escape sequence.
+*/
+func Sixel(img image.Image, scale int) string {
+	b := img.Bounds()
+	width, height := b.Dx()*scale, b.Dy()*scale
+
+	// Map every screen pixel to a palette index, or -1 when transparent.
+	indices := make([][]int, height)
+	used := map[int]bool{}
+	for y := range indices {
+		indices[y] = make([]int, width)
+		for x := range indices[y] {
+			c, ok := pixel(img, b.Min.X+x/scale, b.Min.Y+y/scale)
+			if !ok {
+				indices[y][x] = -1
+				continue
+			}
+			idx := cubeIndex(c)
+			indices[y][x] = idx
+			used[idx] = true
+		}
+	}
+
+	var sb strings.Builder
+	// P2=1 keeps unpainted pixels transparent.
+	fmt.Fprintf(&sb, "\x1bP0;1;0q\"1;1;%d;%d", width, height)
+	for idx := range 216 {
+		if used[idx] {
+			r, g, b := idx/36, idx/6%6, idx%6
+			fmt.Fprintf(&sb, "#%d;2;%d;%d;%d", idx, r*20, g*20, b*20)
+		}
+	}
+
+	for band := 0; band < height; band += 6 {
+		first := true
+		for idx := range 216 {
+			if !used[idx] {
+				continue
+			}
+			row := make([]byte, width)
+			painted := false
+			for x := 0; x < width; x++ {
+				bits := 0
+				for i := 0; i < 6 && band+i < height; i++ {
+					if indices[band+i][x] == idx {
+						bits |= 1 << i
+					}
+				}
+				row[x] = byte(63 + bits)
+				painted = painted || bits != 0
+			}
+			if !painted {
+				continue
+			}
+			if !first {
+				sb.WriteByte('$')
+			}
+			first = false
+			fmt.Fprintf(&sb, "#%d", idx)
+			writeSixelRun(&sb, row)
+		}
+		sb.WriteByte('-')
+	}
+	sb.WriteString("\x1b\\\n")
+	return sb.String()
+}
+
+// cubeIndex maps a color to the nearest entry of the 6x6x6 color cube.
+func cubeIndex(c RGB) int {
+	level := func(v uint8) int { return (int(v)*5 + 127) / 255 }
+	return level(c.R)*36 + level(c.G)*6 + level(c.B)
+}
+
+// writeSixelRun writes sixel characters using run-length encoding.
+func writeSixelRun(sb *strings.Builder, row []byte) {
+	for i := 0; i < len(row); {
+		j := i
+		for j < len(row) && row[j] == row[i] {
+			j++
+		}
+		if n := j - i; n > 3 {
+			fmt.Fprintf(sb, "!%d%c", n, row[i])
+		} else {
+			sb.Write(row[i:j])
+		}
+		i = j
+	}
+}
@@ -0,0 +1,169 @@
+package search
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Index ranks names by how closely they match a search term.
+type Index struct {
+	names    []string
+	trigrams []map[string]bool
+}
+
+// Match is a name found by a search, with a score between 0 and 1.
+type Match struct {
+	Name  string
+	Score float64
+}
+
+/*
+NewIndex builds an index over the given names.
+
+Parameters:
+- names: The names to search, e.g. every Pokemon name.
+
+Returns:
+- *Index: A new Index instance.
+*/
+func NewIndex(names []string) *Index {
+	idx := &Index{
+		names:    names,
+		trigrams: make([]map[string]bool, len(names)),
+	}
+	for i, name := range names {
+		idx.trigrams[i] = trigrams(name)
+	}
+	return idx
+}
+
+/*
+Search returns the names that best match term, best first.
+
+Names are ranked by a mix of trigram similarity and Levenshtein distance, and
+names containing the term rank above other near misses. Misspelled words
+inside longer names are matched too. Dashes and spaces are treated alike,
+so "sunyshore city" finds "sunyshore-city-area".
+
+Parameters:
+- term: The text to look for.
+- minScore: The lowest score to include, between 0 and 1.
+- limit: The maximum number of matches to return.
+
+Returns:
+- []Match: The matching names with their scores.
+*/
+func (idx *Index) Search(term string, minScore float64, limit int) []Match {
+	term = normalize(term)
+	if term == "" {
+		return nil
+	}
+	termTrigrams := trigrams(term)
+
+	matches := []Match{}
+	for i, name := range idx.names {
+		score := similarity(term, termTrigrams, name, idx.trigrams[i])
+		if score >= minScore {
+			matches = append(matches, Match{Name: name, Score: score})
+		}
+	}
+
+	slices.SortFunc(matches, func(a, b Match) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// similarity scores how well name matches term, from 0 (nothing in common) to 1 (equal).
+func similarity(term string, termTrigrams map[string]bool, name string, nameTrigrams map[string]bool) float64 {
+	name = normalize(name)
+	if name == term {
+		return 1
+	}
+	if strings.Contains(name, term) {
+		// Prefer names where the term covers more of the name.
+		return 0.8 + 0.19*float64(len(term))/float64(len(name))
+	}
+
+	shared := 0
+	for t := range termTrigrams {
+		if nameTrigrams[t] {
+			shared++
+		}
+	}
+	jaccard := 0.0
+	if union := len(termTrigrams) + len(nameTrigrams) - shared; union > 0 {
+		jaccard = float64(shared) / float64(union)
+	}
+
+	longest := max(len(term), len(name))
+	edits := 1 - float64(Levenshtein(term, name))/float64(longest)
+
+	return 0.79 * max((jaccard+edits)/2, 0.9*partialSimilarity(term, name))
+}
+
+/*
+partialSimilarity compares term with the parts of name that start at a word
+boundary and have the same length as term, so a misspelled first word like
+"pastria" still matches "pastoria-city-area". Returns the best match from 0 to 1.
+*/
+func partialSimilarity(term, name string) float64 {
+	best := 0.0
+	runes := []rune(name)
+	n := len([]rune(term))
+	for start := 0; start+n <= len(runes); start++ {
+		if start > 0 && runes[start-1] != '-' {
+			continue
+		}
+		edits := Levenshtein(term, string(runes[start:start+n]))
+		best = max(best, 1-float64(edits)/float64(n))
+	}
+	return best
+}
+
+// normalize lowercases s and turns spaces into dashes, the way API names are written.
+func normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), "-")
+}
+
+// trigrams returns the set of three-letter sequences in s, padded at both ends.
+func trigrams(s string) map[string]bool {
+	padded := []rune("  " + normalize(s) + " ")
+	set := map[string]bool{}
+	for i := 0; i+3 <= len(padded); i++ {
+		set[string(padded[i:i+3])] = true
+	}
+	return set
+}
+
+/*
+Levenshtein returns the number of single-character insertions, deletions and
+substitutions needed to turn a into b.
+*/
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,61 @@
+package search
+
+import (
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"pikachu", "pikachu", 0},
+		{"pikachu", "pikachoo", 2},
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+	}
+	for _, c := range cases {
+		if actual := Levenshtein(c.a, c.b); actual != c.expected {
+			t.Errorf("Levenshtein(%q, %q) = %d, expected %d", c.a, c.b, actual, c.expected)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	idx := NewIndex([]string{
+		"pikachu",
+		"pichu",
+		"raichu",
+		"charmander",
+		"sunyshore-city-area",
+		"sunyshore-city-gym",
+		"pastoria-city-area",
+	})
+
+	cases := []struct {
+		term     string
+		expected string
+	}{
+		{term: "pikachu", expected: "pikachu"},
+		{term: "pikchu", expected: "pikachu"},
+		{term: "Charmandr", expected: "charmander"},
+		{term: "sunyshore city area", expected: "sunyshore-city-area"},
+		{term: "sunnyshore-city-area", expected: "sunyshore-city-area"},
+		{term: "pastria", expected: "pastoria-city-area"},
+	}
+	for _, c := range cases {
+		matches := idx.Search(c.term, 0.3, 3)
+		if len(matches) == 0 {
+			t.Errorf("Search(%q): expected a match", c.term)
+			continue
+		}
+		if matches[0].Name != c.expected {
+			t.Errorf("Search(%q): expected %s first, got %+v", c.term, c.expected, matches)
+		}
+	}
+
+	if matches := idx.Search("xyzzy", 0.3, 3); len(matches) != 0 {
+		t.Errorf("Search(%q): expected no matches, got %+v", "xyzzy", matches)
+	}
+}
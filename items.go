@@ -0,0 +1,191 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+/*
+commandBag displays the items in the player's bag with their quantities and
+categories, and the player's money.
+*/
+func commandBag(cfg *config, flags flagSet, args ...string) error {
+	if len(cfg.bag) == 0 {
+		return messages.Errorf("your bag is empty. you have %s", formatMoney(cfg.money))
+	}
+
+	records := []bagRecord{}
+	for _, name := range cfg.bag.Items() {
+		// The category is only a hint, so items that can't be fetched still show.
+		category := ""
+		if item, err := cfg.pokeapiClient.GetItem(name); err == nil {
+			category = item.Category.Name
+		}
+		records = append(records, bagRecord{Item: name, Quantity: cfg.bag[name], Category: category})
+	}
+	return printRecords(cfg, records, func() {
+		rows := [][]string{}
+		for _, r := range records {
+			rows = append(rows, []string{r.Item, strconv.Itoa(r.Quantity), r.Category})
+		}
+		cfg.ui.Table([]string{cfg.msg.Sprintf("Item"), cfg.msg.Sprintf("Qty"), cfg.msg.Sprintf("Category")}, rows)
+		cfg.msg.Printf("Money: %s\n", formatMoney(cfg.money))
+	})
+}
+
+/*
+commandItem displays an item's category, price, fling power and effect, and
+how many of it the player has.
+*/
+func commandItem(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 1 {
+		return messages.Errorf("you must provide an item name")
+	}
+
+	name := toSlug(args[0])
+	item, err := cfg.pokeapiClient.GetItem(name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointItem, name, err)
+	}
+
+	// Items name their flavor texts differently from other resources.
+	entries := []pokeapi.FlavorText{}
+	for _, entry := range item.FlavorTextEntries {
+		versionGroup := entry.VersionGroup
+		entries = append(entries, pokeapi.FlavorText{FlavorText: entry.Text, Language: entry.Language, VersionGroup: &versionGroup})
+	}
+	record := itemRecord{
+		ID:         item.ID,
+		Name:       item.Name,
+		Category:   item.Category.Name,
+		Cost:       item.Cost,
+		FlingPower: item.FlingPower,
+		Effect:     localizedEffect(item.EffectEntries, cfg.language).ShortEffect,
+		FlavorText: flavorText(entries, cfg.language, cfg.game),
+		InBag:      cfg.bag[item.Name],
+	}
+	return printRecords(cfg, record, func() {
+		fmt.Println(cfg.ui.Bold(cfg.msg.Sprintf("Item: %s", record.Name)))
+		cfg.msg.Printf("Category: %s\n", record.Category)
+		cfg.msg.Printf("Price: %s\n", formatMoney(record.Cost))
+		cfg.msg.Printf("Fling power: %s\n", optionalInt(record.FlingPower))
+		if record.Effect != "" {
+			cfg.msg.Printf("Effect: %s\n", record.Effect)
+		}
+		if record.FlavorText != "" {
+			cfg.msg.Printf("Description: %s\n", record.FlavorText)
+		}
+		cfg.msg.Printf("In your bag: %d\n", record.InBag)
+	})
+}
+
+/*
+commandUse uses an item from the bag on one of the player's Pokemon: healing
+items restore HP, revives bring back fainted Pokemon, a rare candy raises the
+level by one and evolution stones evolve the Pokemon that react to them.
+The item is only used up when it has an effect.
+*/
+func commandUse(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 2 {
+		return messages.Errorf("usage: use <item> <pokemon>")
+	}
+	name := toSlug(args[0])
+	if cfg.bag[name] == 0 {
+		return messages.Errorf("you don't have any %s", name)
+	}
+	item, err := cfg.pokeapiClient.GetItem(name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointItem, name, err)
+	}
+	p, _, err := findOwned(cfg, args[1])
+	if err != nil {
+		return err
+	}
+	species, err := speciesData(cfg, p.Species)
+	if err != nil {
+		return err
+	}
+
+	if err := useItem(cfg, item, p, species); err != nil {
+		return err
+	}
+	return cfg.bag.Remove(name, 1)
+}
+
+// useItem applies an item's effect to a Pokemon, or explains why it has none.
+func useItem(cfg *config, item pokeapi.Item, p *game.Pokemon, species pokeapi.Pokemon) error {
+	if amount, ok := game.HealAmount(item.Name); ok {
+		if p.CurrentHP == 0 {
+			return messages.Errorf("%s has fainted. use a revive first", p.Name())
+		}
+		if p.CurrentHP == p.MaxHP(species) {
+			return messages.Errorf("it won't have any effect. %s is at full health", p.Name())
+		}
+		healed := p.Heal(amount, species)
+		cfg.msg.Printf("%s recovered %d HP. (%d/%d HP)\n", p.Name(), healed, p.CurrentHP, p.MaxHP(species))
+		return nil
+	}
+
+	if revives, full := game.IsRevive(item.Name); revives {
+		if p.CurrentHP > 0 {
+			return messages.Errorf("it won't have any effect. %s hasn't fainted", p.Name())
+		}
+		p.CurrentHP = max(1, p.MaxHP(species)/2)
+		if full {
+			p.CurrentHP = p.MaxHP(species)
+		}
+		cfg.msg.Printf("%s was revived! (%d/%d HP)\n", p.Name(), p.CurrentHP, p.MaxHP(species))
+		return nil
+	}
+
+	if item.Name == "rare-candy" {
+		if p.Level >= game.MaxLevel {
+			return messages.Errorf("it won't have any effect. %s is at the highest level", p.Name())
+		}
+		rate, err := growthRate(cfg, species)
+		if err != nil {
+			return err
+		}
+		return awardXP(cfg, p, species, game.XPForLevel(rate, p.Level+1)-p.XP)
+	}
+
+	if item.Category.Name == "evolution" {
+		return evolveWithItem(cfg, item, p, species)
+	}
+
+	if game.IsBall(item.Category.Name) {
+		return messages.Errorf("throw balls at wild Pokemon with: catch --ball %s", item.Name)
+	}
+	return messages.Errorf("%s can't be used on a Pokemon", item.Name)
+}
+
+// evolveWithItem evolves a Pokemon whose species reacts to an evolution item.
+func evolveWithItem(cfg *config, item pokeapi.Item, p *game.Pokemon, species pokeapi.Pokemon) error {
+	speciesInfo, err := cfg.pokeapiClient.GetPokemonSpecies(species.Species.Name)
+	if err != nil {
+		return err
+	}
+	chain, err := cfg.pokeapiClient.GetEvolutionChain(speciesInfo.EvolutionChain.URL)
+	if err != nil {
+		return err
+	}
+	next := game.EvolutionByItem(chain, speciesInfo.Name, item.Name)
+	if next == "" {
+		return messages.Errorf("it won't have any effect on %s", p.Name())
+	}
+
+	evolved, err := speciesData(cfg, next)
+	if err != nil {
+		return err
+	}
+	old := p.Name()
+	p.Evolve(species, evolved)
+	cfg.caughtPokemon[evolved.Name] = evolved
+	cfg.pokedex.See(evolved.Name, evolved.ID)
+	cfg.msg.Printf("What? %s is evolving! Congratulations! %s evolved into %s!\n", old, old, evolved.Name)
+	return nil
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"errors"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+	"github.com/OferRavid/pokedexcli/internal/render"
+)
+
+// defaultLanguage is the language of texts that aren't available in the chosen one.
+const defaultLanguage = "en"
+
+// nameWorkers is the number of localized names fetched in parallel for a table.
+const nameWorkers = 8
+
+// localizing reports whether names are shown in a language other than English.
+// In English the PokeAPI identifiers are shown, since commands take them.
+func localizing(cfg *config) bool {
+	return cfg.language != "" && cfg.language != defaultLanguage
+}
+
+// localizedName returns a resource's name in the given language, or in the
+// default language when it hasn't been translated, or "" when it has neither.
+func localizedName(names []pokeapi.Name, language string) string {
+	found := ""
+	for _, name := range names {
+		switch name.Language.Name {
+		case language:
+			return name.Name
+		case defaultLanguage:
+			found = name.Name
+		}
+	}
+	return found
+}
+
+// resourceName returns the name of a fetched resource in the chosen language,
+// or its identifier in English or when it hasn't been named.
+func resourceName(cfg *config, names []pokeapi.Name, identifier string) string {
+	if name := localizedName(names, cfg.language); localizing(cfg) && name != "" {
+		return name
+	}
+	return identifier
+}
+
+/*
+displayName returns the name of a resource in the chosen language, falling
+back to its English name and then to its identifier. Names are fetched the
+first time they are shown and kept for the session, unless the request failed,
+so they are fetched again next time.
+
+Parameters:
+- cfg: The application configuration.
+- endpoint: The endpoint of the resource, e.g. pokeapi.EndpointMove.
+- name: The identifier of the resource, e.g. "thunderbolt".
+
+Returns:
+- string: The name to show, e.g. "Rayo" in Spanish.
+*/
+func displayName(cfg *config, endpoint, name string) string {
+	if !localizing(cfg) || name == "" {
+		return name
+	}
+	key := endpoint + "/" + name
+	if localized, ok := cfg.localNames[key]; ok {
+		return localized
+	}
+
+	localized, keep := fetchName(cfg, endpoint, name)
+	if !keep {
+		return localized
+	}
+	if cfg.localNames == nil {
+		cfg.localNames = map[string]string{}
+	}
+	cfg.localNames[key] = localized
+	return localized
+}
+
+// fetchName fetches a resource's name in the chosen language, or returns its
+// identifier when it can't be fetched. It reports whether the name can be kept:
+// it can unless the request failed, since a resource that doesn't exist won't
+// be named later either.
+func fetchName(cfg *config, endpoint, name string) (string, bool) {
+	names, err := cfg.pokeapiClient.GetNames(endpoint, name)
+	if err != nil {
+		return name, errors.Is(err, pokeapi.ErrNotFound)
+	}
+	return resourceName(cfg, names, name), true
+}
+
+/*
+prefetchNames fetches the names of several resources in the chosen language
+in parallel, so a table's names aren't fetched one at a time as it's built.
+Names that were already fetched are skipped, names whose request failed aren't
+kept, and nothing is fetched in English.
+
+Parameters:
+- cfg: The application configuration.
+- endpoint: The endpoint of the resources, e.g. pokeapi.EndpointPokemonSpecies.
+- names: The identifiers of the resources.
+*/
+func prefetchNames(cfg *config, endpoint string, names []string) {
+	if !localizing(cfg) {
+		return
+	}
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	fetched := map[string]string{}
+	queued := map[string]bool{}
+	sem := make(chan struct{}, nameWorkers)
+	for _, name := range names {
+		key := endpoint + "/" + name
+		if _, ok := cfg.localNames[key]; ok || name == "" || queued[key] {
+			continue
+		}
+		queued[key] = true
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			localized, keep := fetchName(cfg, endpoint, name)
+			if !keep {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			fetched[key] = localized
+		}()
+	}
+	wg.Wait()
+
+	if cfg.localNames == nil {
+		cfg.localNames = map[string]string{}
+	}
+	maps.Copy(cfg.localNames, fetched)
+}
+
+// displayNames returns the names of several resources in the chosen language.
+func displayNames(cfg *config, endpoint string, names []string) []string {
+	localized := []string{}
+	for _, name := range names {
+		localized = append(localized, displayName(cfg, endpoint, name))
+	}
+	return localized
+}
+
+// pokemonName returns the name of a Pokemon's species in the chosen language.
+// Forms that aren't named like their species keep their identifier.
+func pokemonName(cfg *config, name string) string {
+	return displayName(cfg, pokeapi.EndpointPokemonSpecies, name)
+}
+
+// ownedName returns an owned Pokemon's nickname, or its species' name in the chosen language.
+func ownedName(cfg *config, p *game.Pokemon) string {
+	if p.Nickname != "" {
+		return p.Nickname
+	}
+	return pokemonName(cfg, p.Species)
+}
+
+// labeledName returns a resource's name in the chosen language followed by
+// its identifier, which is what commands take, when the two differ.
+func labeledName(cfg *config, endpoint, name string) string {
+	localized := displayName(cfg, endpoint, name)
+	if localized == name {
+		return name
+	}
+	return localized + " " + cfg.ui.Colorize(render.Gray, "("+name+")")
+}
+
+// typeBadges formats type badges labeled with the types' names in the chosen language.
+func typeBadges(cfg *config, types []string) string {
+	badges := []string{}
+	for _, name := range types {
+		badges = append(badges, cfg.ui.LabeledTypeBadge(name, displayName(cfg, pokeapi.EndpointType, name)))
+	}
+	return strings.Join(badges, " ")
+}
+
+/*
+useLanguage switches names and messages to a language, given as a PokeAPI
+language code such as "es" or "ja-Hrkt". It returns the language's own name
+for it, e.g. "Español".
+*/
+func useLanguage(cfg *config, code string) (string, error) {
+	code = strings.TrimSpace(code)
+	names, err := cfg.pokeapiClient.GetNames(pokeapi.EndpointLanguage, code)
+	if err != nil {
+		return "", notFoundError(cfg, pokeapi.EndpointLanguage, code, err)
+	}
+	cfg.language = code
+	cfg.msg = messages.New(code)
+	cfg.localNames = map[string]string{}
+
+	// Languages are named in themselves when the PokeAPI knows how.
+	for _, name := range names {
+		if name.Language.Name == code {
+			return name.Name, nil
+		}
+	}
+	return code, nil
+}
+
+// setLanguage switches to a language and tells the player.
+func setLanguage(cfg *config, code string) error {
+	name, err := useLanguage(cfg, code)
+	if err != nil {
+		return err
+	}
+	cfg.msg.Printf("Showing names and messages in %s.\n", name)
+	return nil
+}
+
+/*
+commandLanguage shows locations, Pokemon, moves, abilities and types by their
+names in a language, with English for those that weren't translated, and
+translates the CLI's messages where a catalog exists. Without arguments the
+current language is shown.
+*/
+func commandLanguage(cfg *config, flags flagSet, args ...string) error {
+	switch len(args) {
+	case 0:
+		cfg.msg.Printf("language: %s\n", cfg.language)
+		cfg.msg.Printf("translated messages: %s\n", strings.Join(messages.Languages(), ", "))
+		return nil
+	case 1:
+		return setLanguage(cfg, args[0])
+	default:
+		return messages.Errorf("usage: language [<code>]")
+	}
+}
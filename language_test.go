@@ -0,0 +1,239 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+func TestLocalizedName(t *testing.T) {
+	name := func(text, language string) pokeapi.Name {
+		return pokeapi.Name{Name: text, Language: pokeapi.Resource{Name: language}}
+	}
+	names := []pokeapi.Name{name("Pikachu", "en"), name("ピカチュウ", "ja-Hrkt"), name("Pikachu", "es")}
+	cases := []struct {
+		names    []pokeapi.Name
+		language string
+		expected string
+	}{
+		{names: names, language: "ja-Hrkt", expected: "ピカチュウ"},
+		{names: names, language: "ko", expected: "Pikachu"},
+		{names: []pokeapi.Name{name("Eisenschweif", "de")}, language: "fr", expected: ""},
+		{names: nil, language: "es", expected: ""},
+	}
+	for _, c := range cases {
+		if got := localizedName(c.names, c.language); got != c.expected {
+			t.Errorf("%s: expected %q, got %q", c.language, c.expected, got)
+		}
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	names := []pokeapi.Name{
+		{Name: "Viridian Forest", Language: pokeapi.Resource{Name: "en"}},
+		{Name: "Bosque Verde", Language: pokeapi.Resource{Name: "es"}},
+	}
+	cases := []struct {
+		language string
+		names    []pokeapi.Name
+		expected string
+	}{
+		{language: "", names: names, expected: "viridian-forest"},
+		{language: "en", names: names, expected: "viridian-forest"},
+		{language: "es", names: names, expected: "Bosque Verde"},
+		{language: "fr", names: names, expected: "Viridian Forest"},
+		{language: "es", names: nil, expected: "viridian-forest"},
+	}
+	for _, c := range cases {
+		cfg := &config{language: c.language}
+		if got := resourceName(cfg, c.names, "viridian-forest"); got != c.expected {
+			t.Errorf("%q: expected %q, got %q", c.language, c.expected, got)
+		}
+	}
+}
+
+// isTranslated reports whether a call is cfg.msg.Printf, cfg.msg.Sprintf or messages.Errorf.
+func isTranslated(call *ast.CallExpr) bool {
+	method, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	switch x := method.X.(type) {
+	case *ast.SelectorExpr:
+		return x.Sel.Name == "msg" && (method.Sel.Name == "Printf" || method.Sel.Name == "Sprintf")
+	case *ast.Ident:
+		return x.Name == "messages" && method.Sel.Name == "Errorf"
+	}
+	return false
+}
+
+// isTextMap reports whether a composite literal is a map of messages.Text.
+func isTextMap(lit *ast.CompositeLit) bool {
+	mapType, ok := lit.Type.(*ast.MapType)
+	if !ok {
+		return false
+	}
+	value, ok := mapType.Value.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := value.X.(*ast.Ident)
+	return ok && pkg.Name == "messages" && value.Sel.Name == "Text"
+}
+
+/*
+translatedFormats returns the format strings the CLI passes to cfg.msg and
+messages.Errorf, along with its texts: the command descriptions and the
+values of maps of messages.Text.
+*/
+func translatedFormats(t *testing.T) []string {
+	t.Helper()
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, ".", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	formats := []string{}
+	add := func(expr ast.Expr) {
+		literal, ok := expr.(*ast.BasicLit)
+		if !ok || literal.Kind != token.STRING {
+			t.Errorf("%s: translated messages need a literal format string", fset.Position(expr.Pos()))
+			return
+		}
+		format, err := strconv.Unquote(literal.Value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		formats = append(formats, format)
+	}
+	for name, file := range packages["main"].Files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.CallExpr:
+				if len(n.Args) > 0 && isTranslated(n) {
+					add(n.Args[0])
+				}
+			case *ast.KeyValueExpr:
+				if key, ok := n.Key.(*ast.Ident); ok && key.Name == "description" {
+					add(n.Value)
+				}
+			case *ast.CompositeLit:
+				if isTextMap(n) {
+					for _, elt := range n.Elts {
+						add(elt.(*ast.KeyValueExpr).Value)
+					}
+				}
+			}
+			return true
+		})
+	}
+	slices.Sort(formats)
+	return slices.Compact(formats)
+}
+
+func TestMessagesAreTranslated(t *testing.T) {
+	used := translatedFormats(t)
+	translated := messages.Formats()
+	for _, format := range used {
+		if !slices.Contains(translated, format) {
+			t.Errorf("%q is a translated message but has no translations", format)
+		}
+	}
+	for _, format := range translated {
+		if !slices.Contains(used, format) {
+			t.Errorf("%q is translated but never used", format)
+		}
+	}
+}
+
+func TestPrefetchNames(t *testing.T) {
+	fixtures := map[string]string{
+		"move/surf":         `{"names": [{"name": "Surf", "language": {"name": "en"}}, {"name": "Surf", "language": {"name": "es"}}]}`,
+		"move/tackle":       `{"names": [{"name": "Placaje", "language": {"name": "es"}}]}`,
+		"move/rapid-spin":   `{"names": [{"name": "Rapid Spin", "language": {"name": "en"}}]}`,
+		"move/thunder-wave": failRequest,
+	}
+	api := &fakeAPI{responses: fixtures}
+	cfg := newFakeConfig(api)
+	cfg.language = "es"
+	cfg.localNames = map[string]string{"move/thunderbolt": "Rayo"}
+	prefetchNames(cfg, pokeapi.EndpointMove, []string{"thunderbolt", "tackle", "tackle", "", "surf", "rapid-spin", "made-up", "thunder-wave"})
+
+	// Untranslated moves fall back to English and moves that don't exist keep
+	// their identifier, but failed requests aren't kept.
+	expected := map[string]string{
+		"move/thunderbolt": "Rayo",
+		"move/tackle":      "Placaje",
+		"move/surf":        "Surf",
+		"move/rapid-spin":  "Rapid Spin",
+		"move/made-up":     "made-up",
+	}
+	if !maps.Equal(cfg.localNames, expected) {
+		t.Errorf("expected %v, got %v", expected, cfg.localNames)
+	}
+	if requested := api.requested(); len(requested) != 5 || slices.Contains(requested, "move/thunderbolt") {
+		t.Errorf("expected each new move to be requested once, got %v", requested)
+	}
+
+	// The failed name is fetched again once the API answers.
+	if name := displayName(cfg, pokeapi.EndpointMove, "thunder-wave"); name != "thunder-wave" {
+		t.Errorf("expected the identifier while the request fails, got %q", name)
+	}
+	if _, ok := cfg.localNames["move/thunder-wave"]; ok {
+		t.Error("expected a failed name not to be kept")
+	}
+	fixtures["move/thunder-wave"] = `{"names": [{"name": "Onda Trueno", "language": {"name": "es"}}]}`
+	if name := displayName(cfg, pokeapi.EndpointMove, "thunder-wave"); name != "Onda Trueno" {
+		t.Errorf("expected Onda Trueno, got %q", name)
+	}
+
+	english := newFakeConfig(api)
+	prefetchNames(english, pokeapi.EndpointMove, []string{"surf"})
+	if len(english.localNames) != 0 {
+		t.Errorf("expected no names to be fetched in English, got %v", english.localNames)
+	}
+}
+
+func TestTranslatedOutput(t *testing.T) {
+	cfg := newFakeConfig(&fakeAPI{})
+	cfg.language = "es"
+	cfg.msg = messages.New(cfg.language)
+
+	printed := captureStdout(t, cfg, func() {
+		if err := commandHelp(cfg, flagSet{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if !strings.Contains(printed, "regions: Enumera todas las regiones\n") {
+		t.Errorf("expected translated descriptions, got:\n%s", printed)
+	}
+
+	err := notFoundError(cfg, pokeapi.EndpointLocationArea, "route-99", pokeapi.ErrNotFound)
+	if got := cfg.msg.Error(err); got != `no se encontró zona "route-99"` {
+		t.Errorf("expected the kind to be translated, got %q", got)
+	}
+
+	p := &game.Pokemon{Species: "pikachu"}
+	cfg.caughtPokemon["pikachu"] = pokeapi.Pokemon{Name: "pikachu"}
+	for box, expected := range map[int]string{0: "equipo", 2: "caja 2"} {
+		record, err := newOwnedRecord(cfg, p, box)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if record.Storage != expected {
+			t.Errorf("expected %q, got %q", expected, record.Storage)
+		}
+	}
+}
@@ -1,27 +1,112 @@
 package main
 
 import (
+	"bufio"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/output"
 	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+	"github.com/OferRavid/pokedexcli/internal/render"
+	"github.com/OferRavid/pokedexcli/internal/search"
 )
 
 /*
 main initializes the application by creating a PokeAPI client with specific timeouts,
 sets up the configuration struct, and starts the REPL (Read-Eval-Print) Loop.
+If a command is given on the command line it is run once instead of starting the REPL.
 */
 func main() {
+	outputFlag := flag.String("output", string(output.Table), "output format: table, json, yaml or csv")
+	modeFlag := flag.String("mode", gameMode, "game: only caught Pokemon can be inspected, reference: any Pokemon can")
+	languageFlag := flag.String("language", defaultLanguage, "PokeAPI code of the language names and messages are shown in, e.g. es")
+	saveFlag := flag.String("save", defaultSavePath(), `file to save your progress in, or "" to not save`)
+	flag.Parse()
+
+	format, err := output.ParseFormat(*outputFlag)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	mode, err := parseMode(*modeFlag)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	// Files such as downloaded sprites are kept in the user's cache directory.
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		cacheDir = os.TempDir()
+	}
+
 	// Create a new PokeAPI client with a 5-second HTTP timeout and a 5-minute cache duration.
-	pokeClient := pokeapi.NewClient(5*time.Second, time.Minute*5)
+	pokeClient := pokeapi.NewClient(5*time.Second, time.Minute*5, filepath.Join(cacheDir, "pokedexcli"))
 
 	// Initialize the application configuration, including caches for caught Pokemon
 	// and a reference to the PokeAPI client.
 	cfg := &config{
-		caughtPokemon:      map[string]pokeapi.Pokemon{},
-		caughtPokemonCount: map[string]int{},
-		pokeapiClient:      pokeClient,
+		caughtPokemon:  map[string]pokeapi.Pokemon{},
+		storage:        game.NewStorage(),
+		bag:            game.StarterBag(),
+		money:          game.StartingMoney,
+		farm:           game.NewFarm(),
+		pokedex:        game.NewPokedex(),
+		savePath:       *saveFlag,
+		pokeapiClient:  pokeClient,
+		locationsLimit: pokeapi.DefaultPageSize,
+		output:         format,
+		mode:           mode,
+		language:       defaultLanguage,
+		ui:             render.New(os.Stdout),
+		nameIndexes:    map[string]*search.Index{},
+		rng:            rand.New(rand.NewSource(time.Now().UnixNano())),
+		input:          bufio.NewScanner(os.Stdin),
+	}
+
+	// Names and messages are in English unless another language is chosen.
+	if *languageFlag != defaultLanguage {
+		if _, err := useLanguage(cfg, *languageFlag); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
+
+	// Continue the saved game, if there is one.
+	if err := loadGame(cfg); err != nil {
+		fmt.Fprintln(os.Stderr, cfg.msg.Error(err))
+		os.Exit(1)
+	}
+
+	// Run a single command non-interactively, e.g. `pokedexcli --output json map`.
+	if flag.NArg() > 0 {
+		if err := runCommand(cfg, flag.Args()); err != nil {
+			fmt.Fprintln(os.Stderr, cfg.msg.Error(err))
+			os.Exit(1)
+		}
+		if err := saveGame(cfg); err != nil {
+			fmt.Fprintln(os.Stderr, cfg.msg.Error(messages.Errorf("can't save the game: %w", err)))
+			os.Exit(1)
+		}
+		return
 	}
 
 	// Start the REPL to process user commands.
 	startRepl(cfg)
 }
+
+// defaultSavePath returns the save file in the user's config directory, or ""
+// if there is no such directory.
+func defaultSavePath() string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(configDir, "pokedexcli", "save.json")
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// learnMethods are the ways a Pokemon learns moves, in the order learnsets list them.
+var learnMethods = []string{"level-up", "machine", "egg", "tutor"}
+
+/*
+commandMove displays a move's type, damage class, power, accuracy, PP and
+priority, and what it does, as they were in the chosen game.
+*/
+func commandMove(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 1 {
+		return messages.Errorf("you must provide a move name")
+	}
+
+	name := toSlug(args[0])
+	move, err := cfg.pokeapiClient.GetMove(name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointMove, name, err)
+	}
+	move, err = gameMove(cfg, move)
+	if err != nil {
+		return err
+	}
+
+	record := newMoveRecord(move, cfg.language)
+	record.FlavorText = flavorText(move.FlavorTextEntries, cfg.language, cfg.game)
+	return printRecords(cfg, record, func() {
+		ui := cfg.ui
+		fmt.Println(ui.Bold(cfg.msg.Sprintf("Move: %s", resourceName(cfg, move.Names, record.Name))))
+		cfg.msg.Printf("Type: %s\n", typeBadges(cfg, []string{record.Type}))
+		cfg.msg.Printf("Class: %s\n", record.DamageClass)
+		cfg.msg.Printf("Power: %s\n", optionalInt(record.Power))
+		if record.Accuracy != nil {
+			cfg.msg.Printf("Accuracy: %d%%\n", *record.Accuracy)
+		} else {
+			fmt.Println(cfg.msg.Sprintf("Accuracy: -"))
+		}
+		cfg.msg.Printf("PP: %s\n", optionalInt(record.PP))
+		cfg.msg.Printf("Priority: %+d\n", record.Priority)
+		cfg.msg.Printf("Target: %s\n", record.Target)
+		if record.Effect != "" {
+			cfg.msg.Printf("Effect: %s\n", record.Effect)
+		}
+		if record.FlavorText != "" {
+			cfg.msg.Printf("Description: %s\n", record.FlavorText)
+		}
+		if record.Ailment != "" && record.Ailment != "none" {
+			cfg.msg.Printf("Ailment: %s (%d%% chance)\n", record.Ailment, record.AilmentChance)
+		}
+		if record.CritRate > 0 {
+			cfg.msg.Printf("Critical hit stage: +%d\n", record.CritRate)
+		}
+		if record.Drain > 0 {
+			cfg.msg.Printf("Drain: heals %d%% of the damage dealt\n", record.Drain)
+		} else if record.Drain < 0 {
+			cfg.msg.Printf("Recoil: %d%% of the damage dealt\n", -record.Drain)
+		}
+		if record.FlinchChance > 0 {
+			cfg.msg.Printf("Flinch chance: %d%%\n", record.FlinchChance)
+		}
+	})
+}
+
+// optionalInt formats a value the PokeAPI may leave out, showing "-" when it is missing.
+func optionalInt(n *int) string {
+	if n == nil {
+		return "-"
+	}
+	return strconv.Itoa(*n)
+}
+
+// moveEffect returns the short description of a move's effect, with its
+// effect chance filled in, in the given language or the default one.
+func moveEffect(move pokeapi.Move, language string) string {
+	effect := localizedEffect(move.EffectEntries, language).ShortEffect
+	if move.EffectChance != nil {
+		effect = strings.ReplaceAll(effect, "$effect_chance", strconv.Itoa(*move.EffectChance))
+	}
+	return effect
+}
+
+/*
+commandMoves lists the moves a Pokemon can learn, grouped by how they are
+learned and sorted by level, in the version group given with --version-group
+or the chosen game's. Without either each move and method is listed once, at
+the lowest level it is learned in any game.
+*/
+func commandMoves(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 1 {
+		return messages.Errorf("usage: moves <pokemon> [--method level-up|machine|egg|tutor] [--version-group X]")
+	}
+	method := toSlug(flags.get("method"))
+	versionGroup := cfg.game.versionGroup
+	if flags.has("version-group") {
+		versionGroup = toSlug(flags.get("version-group"))
+	}
+
+	name := toSlug(args[0])
+	pokemon, err := speciesData(cfg, name)
+	if err != nil {
+		return err
+	}
+
+	records := learnset(pokemon, method, versionGroup)
+	if len(records) == 0 {
+		return messages.Errorf("no moves found for %s with the given filters", pokemonName(cfg, pokemon.Name))
+	}
+	return printRecords(cfg, records, func() {
+		moves := []string{}
+		for _, r := range records {
+			moves = append(moves, r.Move)
+		}
+		prefetchNames(cfg, pokeapi.EndpointMove, moves)
+
+		rows := [][]string{}
+		for _, r := range records {
+			level := "-"
+			if r.Method == "level-up" {
+				level = strconv.Itoa(r.Level)
+			}
+			rows = append(rows, []string{level, displayName(cfg, pokeapi.EndpointMove, r.Move), r.Method})
+		}
+		cfg.ui.Table([]string{cfg.msg.Sprintf("Lv"), cfg.msg.Sprintf("Move"), cfg.msg.Sprintf("Method")}, rows)
+	})
+}
+
+/*
+learnset collects the moves a Pokemon learns, optionally only those learned
+by one method or in one version group. A move learned the same way at
+different levels in different games is listed once, at its lowest level.
+Level-up moves come first, sorted by level, then the other methods.
+*/
+func learnset(pokemon pokeapi.Pokemon, method, versionGroup string) []learnsetRecord {
+	type key struct{ move, method string }
+	found := map[key]*learnsetRecord{}
+	records := []*learnsetRecord{}
+	for _, move := range pokemon.Moves {
+		for _, details := range move.VersionGroupDetails {
+			if method != "" && details.MoveLearnMethod.Name != method {
+				continue
+			}
+			if versionGroup != "" && details.VersionGroup.Name != versionGroup {
+				continue
+			}
+
+			k := key{move.Move.Name, details.MoveLearnMethod.Name}
+			r, ok := found[k]
+			if !ok {
+				r = &learnsetRecord{Level: details.LevelLearnedAt, Move: k.move, Method: k.method}
+				found[k] = r
+				records = append(records, r)
+			}
+			r.Level = min(r.Level, details.LevelLearnedAt)
+			if !slices.Contains(r.VersionGroups, details.VersionGroup.Name) {
+				r.VersionGroups = append(r.VersionGroups, details.VersionGroup.Name)
+			}
+		}
+	}
+
+	// Methods outside learnMethods, such as form changes, sort last.
+	methodOrder := func(m string) int {
+		if i := slices.Index(learnMethods, m); i >= 0 {
+			return i
+		}
+		return len(learnMethods)
+	}
+	slices.SortFunc(records, func(a, b *learnsetRecord) int {
+		return cmp.Or(
+			cmp.Compare(methodOrder(a.Method), methodOrder(b.Method)),
+			cmp.Compare(a.Method, b.Method),
+			cmp.Compare(a.Level, b.Level),
+			cmp.Compare(a.Move, b.Move),
+		)
+	})
+
+	result := []learnsetRecord{}
+	for _, r := range records {
+		result = append(result, *r)
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+const testMovesJSON = `{"name": "pikachu", "moves": [
+	{"move": {"name": "thunderbolt"}, "version_group_details": [
+		{"level_learned_at": 0, "move_learn_method": {"name": "machine"}, "version_group": {"name": "red-blue"}}
+	]},
+	{"move": {"name": "quick-attack"}, "version_group_details": [
+		{"level_learned_at": 16, "move_learn_method": {"name": "level-up"}, "version_group": {"name": "red-blue"}},
+		{"level_learned_at": 11, "move_learn_method": {"name": "level-up"}, "version_group": {"name": "diamond-pearl"}}
+	]},
+	{"move": {"name": "thunder-shock"}, "version_group_details": [
+		{"level_learned_at": 1, "move_learn_method": {"name": "level-up"}, "version_group": {"name": "red-blue"}},
+		{"level_learned_at": 1, "move_learn_method": {"name": "level-up"}, "version_group": {"name": "diamond-pearl"}}
+	]}
+]}`
+
+func TestLearnset(t *testing.T) {
+	pokemon := pokeapi.Pokemon{}
+	if err := json.Unmarshal([]byte(testMovesJSON), &pokemon); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		method       string
+		versionGroup string
+		expected     []learnsetRecord
+	}{
+		{
+			expected: []learnsetRecord{
+				{Level: 1, Move: "thunder-shock", Method: "level-up", VersionGroups: []string{"red-blue", "diamond-pearl"}},
+				{Level: 11, Move: "quick-attack", Method: "level-up", VersionGroups: []string{"red-blue", "diamond-pearl"}},
+				{Level: 0, Move: "thunderbolt", Method: "machine", VersionGroups: []string{"red-blue"}},
+			},
+		},
+		{
+			versionGroup: "red-blue",
+			method:       "level-up",
+			expected: []learnsetRecord{
+				{Level: 1, Move: "thunder-shock", Method: "level-up", VersionGroups: []string{"red-blue"}},
+				{Level: 16, Move: "quick-attack", Method: "level-up", VersionGroups: []string{"red-blue"}},
+			},
+		},
+		{method: "egg", expected: []learnsetRecord{}},
+	}
+	for _, c := range cases {
+		actual := learnset(pokemon, c.method, c.versionGroup)
+		if len(actual) != len(c.expected) {
+			t.Errorf("method %q, version group %q: expected %d moves, got %+v", c.method, c.versionGroup, len(c.expected), actual)
+			continue
+		}
+		for i := range actual {
+			a, e := actual[i], c.expected[i]
+			if a.Level != e.Level || a.Move != e.Move || a.Method != e.Method || len(a.VersionGroups) != len(e.VersionGroups) {
+				t.Errorf("method %q, version group %q: move %d: expected %+v, got %+v", c.method, c.versionGroup, i, e, a)
+			}
+		}
+	}
+}
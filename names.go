@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+	"github.com/OferRavid/pokedexcli/internal/search"
+)
+
+const (
+	// allNamesPageSize is large enough to load every name of an endpoint in one request.
+	allNamesPageSize = 100000
+	// suggestionScore is the lowest search score offered as a "did you mean" suggestion.
+	suggestionScore = 0.45
+	maxSuggestions  = 3
+)
+
+// resourceKinds names the resources of the endpoints in not-found errors.
+var resourceKinds = map[string]messages.Text{
+	pokeapi.EndpointAbility:      "ability",
+	pokeapi.EndpointBerry:        "berry",
+	pokeapi.EndpointItem:         "item",
+	pokeapi.EndpointLanguage:     "language",
+	pokeapi.EndpointLocationArea: "location area",
+	pokeapi.EndpointMove:         "move",
+	pokeapi.EndpointPokemon:      "pokemon",
+	pokeapi.EndpointRegion:       "region",
+	pokeapi.EndpointVersion:      "version",
+}
+
+/*
+nameIndex returns the search index over all resource names of an endpoint.
+The names are loaded from the PokeAPI the first time and kept for the session.
+*/
+func nameIndex(cfg *config, endpoint string) (*search.Index, error) {
+	if idx, ok := cfg.nameIndexes[endpoint]; ok {
+		return idx, nil
+	}
+
+	names := []string{}
+	for resource, err := range cfg.pokeapiClient.All(endpoint, pokeapi.IterOptions{PageSize: allNamesPageSize}) {
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, resource.Name)
+	}
+
+	idx := search.NewIndex(names)
+	cfg.nameIndexes[endpoint] = idx
+	return idx, nil
+}
+
+/*
+notFoundError replaces a not-found error from the PokeAPI with a message
+suggesting similar names from the endpoint. Other errors are returned as is.
+*/
+func notFoundError(cfg *config, endpoint, name string, err error) error {
+	if !errors.Is(err, pokeapi.ErrNotFound) {
+		return err
+	}
+
+	kind, ok := resourceKinds[endpoint]
+	if !ok {
+		kind = messages.Text(strings.ReplaceAll(endpoint, "-", " "))
+	}
+	if suggestions := suggest(cfg, endpoint, name); len(suggestions) > 0 {
+		return messages.Errorf("%s %q not found. did you mean %s?", kind, name, joinOr(suggestions))
+	}
+	return messages.Errorf("%s %q not found", kind, name)
+}
+
+// suggest returns the names of an endpoint that are close to name.
+// Suggestions are best effort, so an index that can't be loaded gives none.
+func suggest(cfg *config, endpoint, name string) []string {
+	idx, err := nameIndex(cfg, endpoint)
+	if err != nil {
+		return nil
+	}
+	names := []string{}
+	for _, match := range idx.Search(name, suggestionScore, maxSuggestions) {
+		if match.Name != name {
+			names = append(names, match.Name)
+		}
+	}
+	return names
+}
+
+// joinOr joins words as "a", "a or b" or "a, b or c".
+func joinOr(words []string) string {
+	if len(words) <= 1 {
+		return strings.Join(words, "")
+	}
+	return strings.Join(words[:len(words)-1], ", ") + " or " + words[len(words)-1]
+}
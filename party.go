@@ -0,0 +1,297 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// maxNicknameLength is the longest nickname a Pokemon can be given.
+const maxNicknameLength = 12
+
+// ownedRecord is the structured form of a Pokemon owned by the player.
+type ownedRecord struct {
+	ID       int       `json:"id"`
+	Name     string    `json:"name"`
+	Species  string    `json:"species"`
+	Level    int       `json:"level"`
+	XP       int       `json:"xp"`
+	HP       int       `json:"hp"`
+	MaxHP    int       `json:"max_hp"`
+	Moves    []string  `json:"moves"`
+	Storage  string    `json:"storage"`
+	CaughtAt string    `json:"caught_at"`
+	CaughtOn time.Time `json:"caught_on"`
+}
+
+// newOwnedRecord builds an ownedRecord for a Pokemon kept in the given box (0 for the party).
+// Its species is fetched if it couldn't be when the game was loaded.
+func newOwnedRecord(cfg *config, p *game.Pokemon, box int) (ownedRecord, error) {
+	species, err := speciesData(cfg, p.Species)
+	if err != nil {
+		return ownedRecord{}, err
+	}
+	storage := cfg.msg.Sprintf("party")
+	if box > 0 {
+		storage = cfg.msg.Sprintf("box %d", box)
+	}
+	return ownedRecord{
+		ID:       p.ID,
+		Name:     p.Name(),
+		Species:  p.Species,
+		Level:    p.Level,
+		XP:       p.XP,
+		HP:       p.CurrentHP,
+		MaxHP:    p.MaxHP(species),
+		Moves:    p.Moves,
+		Storage:  storage,
+		CaughtAt: p.CaughtAt,
+		CaughtOn: p.CaughtOn,
+	}, nil
+}
+
+/*
+findOwned looks up an owned Pokemon by ID, nickname or species name.
+Names must identify a single Pokemon; otherwise the matching IDs are listed.
+Returns the Pokemon and the box holding it (0 for the party).
+*/
+func findOwned(cfg *config, ref string) (*game.Pokemon, int, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		p, box := cfg.storage.Find(id)
+		if p == nil {
+			return nil, 0, messages.Errorf("you don't have a Pokemon with ID %d", id)
+		}
+		return p, box, nil
+	}
+
+	matches := []*game.Pokemon{}
+	for _, p := range cfg.storage.All() {
+		if strings.EqualFold(p.Nickname, ref) || p.Species == toSlug(ref) {
+			matches = append(matches, p)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, 0, messages.Errorf("you don't have a Pokemon called %s", ref)
+	case 1:
+		p, box := cfg.storage.Find(matches[0].ID)
+		return p, box, nil
+	default:
+		ids := []string{}
+		for _, p := range matches {
+			ids = append(ids, strconv.Itoa(p.ID))
+		}
+		return nil, 0, messages.Errorf("you have %d Pokemon called %s. use one of their IDs: %s", len(matches), ref, strings.Join(ids, ", "))
+	}
+}
+
+/*
+commandParty displays the Pokemon in the player's party with their level,
+HP and moves.
+*/
+func commandParty(cfg *config, flags flagSet, args ...string) error {
+	if len(cfg.storage.Party) == 0 {
+		return messages.Errorf("your party is empty. go catch some pokemon")
+	}
+
+	records := []ownedRecord{}
+	for _, p := range cfg.storage.Party {
+		record, err := newOwnedRecord(cfg, p, 0)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+	return printRecords(cfg, records, func() {
+		species, moves := []string{}, []string{}
+		for _, r := range records {
+			species = append(species, r.Species)
+			moves = append(moves, r.Moves...)
+		}
+		prefetchNames(cfg, pokeapi.EndpointPokemonSpecies, species)
+		prefetchNames(cfg, pokeapi.EndpointMove, moves)
+
+		rows := [][]string{}
+		for i, r := range records {
+			rows = append(rows, []string{
+				strconv.Itoa(i + 1),
+				strconv.Itoa(r.ID),
+				ownedName(cfg, cfg.storage.Party[i]),
+				pokemonName(cfg, r.Species),
+				strconv.Itoa(r.Level),
+				fmt.Sprintf("%d/%d", r.HP, r.MaxHP),
+				strings.Join(displayNames(cfg, pokeapi.EndpointMove, r.Moves), ", "),
+			})
+		}
+		cfg.ui.Table([]string{cfg.msg.Sprintf("Slot"), "ID", cfg.msg.Sprintf("Name"), cfg.msg.Sprintf("Species"), cfg.msg.Sprintf("Lv"), "HP", cfg.msg.Sprintf("Moves")}, rows)
+	})
+}
+
+/*
+commandBox displays the Pokemon in a PC box, or how full each box is when
+no box number is given.
+*/
+func commandBox(cfg *config, flags flagSet, args ...string) error {
+	if len(args) == 0 {
+		rows := [][]string{}
+		for i, box := range cfg.storage.Boxes {
+			rows = append(rows, []string{strconv.Itoa(i + 1), fmt.Sprintf("%d/%d", len(box), game.BoxSize)})
+		}
+		cfg.ui.Table([]string{cfg.msg.Sprintf("Box"), cfg.msg.Sprintf("Pokemon")}, rows)
+		return nil
+	}
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 1 || n > len(cfg.storage.Boxes) {
+		return messages.Errorf("choose a box from 1 to %d", len(cfg.storage.Boxes))
+	}
+
+	records := []ownedRecord{}
+	for _, p := range cfg.storage.Boxes[n-1] {
+		record, err := newOwnedRecord(cfg, p, n)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+	return printRecords(cfg, records, func() {
+		if len(records) == 0 {
+			cfg.msg.Printf("Box %d is empty.\n", n)
+			return
+		}
+		species := []string{}
+		for _, r := range records {
+			species = append(species, r.Species)
+		}
+		prefetchNames(cfg, pokeapi.EndpointPokemonSpecies, species)
+
+		rows := [][]string{}
+		for i, r := range records {
+			name := ownedName(cfg, cfg.storage.Boxes[n-1][i])
+			rows = append(rows, []string{strconv.Itoa(r.ID), name, pokemonName(cfg, r.Species), strconv.Itoa(r.Level)})
+		}
+		cfg.ui.Table([]string{"ID", cfg.msg.Sprintf("Name"), cfg.msg.Sprintf("Species"), cfg.msg.Sprintf("Lv")}, rows)
+	})
+}
+
+/*
+commandDeposit moves a Pokemon from the party into a PC box, either the one
+given or the first box with room.
+*/
+func commandDeposit(cfg *config, flags flagSet, args ...string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return messages.Errorf("usage: deposit <pokemon> [box]")
+	}
+	p, _, err := findOwned(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	box := 0
+	if len(args) == 2 {
+		if box, err = strconv.Atoi(args[1]); err != nil || box < 1 {
+			return messages.Errorf("choose a box from 1 to %d", len(cfg.storage.Boxes))
+		}
+	}
+
+	box, err = cfg.storage.Deposit(p.ID, box)
+	if err != nil {
+		return err
+	}
+	cfg.msg.Printf("%s was deposited in box %d.\n", p.Name(), box)
+	return nil
+}
+
+/*
+commandWithdraw moves a Pokemon from a PC box into the party.
+*/
+func commandWithdraw(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 1 {
+		return messages.Errorf("usage: withdraw <pokemon>")
+	}
+	p, _, err := findOwned(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	if err := cfg.storage.Withdraw(p.ID); err != nil {
+		return err
+	}
+	cfg.msg.Printf("%s joined your party.\n", p.Name())
+	return nil
+}
+
+/*
+commandNickname gives a Pokemon a nickname. An empty nickname removes it.
+Nicknames keep their case and may contain spaces when quoted.
+*/
+func commandNickname(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 2 {
+		return messages.Errorf(`usage: nickname <pokemon> <nickname> (use "" to remove a nickname)`)
+	}
+	p, _, err := findOwned(cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	nickname := strings.TrimSpace(args[1])
+	if len([]rune(nickname)) > maxNicknameLength {
+		return messages.Errorf("nicknames can be at most %d characters long", maxNicknameLength)
+	}
+	if _, err := strconv.Atoi(nickname); err == nil {
+		return messages.Errorf("nicknames can't be numbers, they would be mistaken for IDs")
+	}
+
+	old := p.Name()
+	p.Nickname = nickname
+	if nickname == "" {
+		cfg.msg.Printf("%s's nickname was removed.\n", old)
+	} else {
+		cfg.msg.Printf("%s is now called %s.\n", old, nickname)
+	}
+	return nil
+}
+
+/*
+commandRelease releases an owned Pokemon back into the wild, which leaves
+some money behind as thanks.
+*/
+func commandRelease(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 1 {
+		return messages.Errorf("usage: release <pokemon>")
+	}
+	p, _, err := findOwned(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	if _, err := cfg.storage.Release(p.ID); err != nil {
+		return err
+	}
+	reward := game.ReleaseReward(p.Level)
+	cfg.money += reward
+	cfg.msg.Printf("%s was released. Bye, %s!\n", p.Name(), p.Name())
+	cfg.msg.Printf("It left %s behind as thanks.\n", formatMoney(reward))
+	return nil
+}
+
+/*
+commandHeal restores the HP of every Pokemon in the party, as a visit to a
+Pokemon Center does.
+*/
+func commandHeal(cfg *config, flags flagSet, args ...string) error {
+	if len(cfg.storage.Party) == 0 {
+		return messages.Errorf("your party is empty. go catch some pokemon")
+	}
+	for _, p := range cfg.storage.Party {
+		species, err := speciesData(cfg, p.Species)
+		if err != nil {
+			return err
+		}
+		p.CurrentHP = p.MaxHP(species)
+	}
+	fmt.Println(cfg.msg.Sprintf("Your Pokemon are fighting fit!"))
+	return nil
+}
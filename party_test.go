@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/output"
+)
+
+func TestPartyFetchesUnloadedSpecies(t *testing.T) {
+	fixtures := map[string]string{
+		"pokemon/pikachu": `{"id": 25, "name": "pikachu", "stats": [{"base_stat": 35, "stat": {"name": "hp"}}]}`,
+	}
+	cfg := newFakeConfig(&fakeAPI{responses: fixtures})
+	cfg.output = output.JSON
+	cfg.unloadedCaught = []string{"pikachu"}
+	pikachu := &game.Pokemon{Species: "pikachu", Level: 50, CurrentHP: 20, IVs: game.Stats{"hp": 31}}
+	if _, err := cfg.storage.Add(pikachu); err != nil {
+		t.Fatal(err)
+	}
+
+	printed := captureStdout(t, cfg, func() {
+		if err := commandParty(cfg, flagSet{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	records := []ownedRecord{}
+	if err := json.Unmarshal([]byte(printed), &records); err != nil {
+		t.Fatalf("%v in %s", err, printed)
+	}
+	maxHP := game.StatValue("hp", 35, 31, 0, 50, game.Nature{})
+	if len(records) != 1 || records[0].MaxHP != maxHP {
+		t.Errorf("expected pikachu with %d max HP, got %+v", maxHP, records)
+	}
+	if _, ok := cfg.caughtPokemon["pikachu"]; !ok || len(cfg.unloadedCaught) != 0 {
+		t.Errorf("expected pikachu to be loaded, got %v and %v", cfg.caughtPokemon, cfg.unloadedCaught)
+	}
+
+	// A species that still can't be fetched stays unloaded.
+	fixtures["pokemon/pikachu"] = failRequest
+	cfg = newFakeConfig(&fakeAPI{responses: fixtures})
+	cfg.unloadedCaught = []string{"pikachu"}
+	if _, err := cfg.storage.Add(pikachu); err != nil {
+		t.Fatal(err)
+	}
+	printed = captureStdout(t, cfg, func() {
+		if err := commandParty(cfg, flagSet{}); err == nil {
+			t.Error("expected the party to fail")
+		}
+	})
+	if printed != "" {
+		t.Errorf("expected nothing to be printed, got %q", printed)
+	}
+	if len(cfg.caughtPokemon) != 0 || !slices.Equal(cfg.unloadedCaught, []string{"pikachu"}) {
+		t.Errorf("expected pikachu to stay unloaded, got %v and %v", cfg.caughtPokemon, cfg.unloadedCaught)
+	}
+}
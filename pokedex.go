@@ -0,0 +1,433 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+	"github.com/OferRavid/pokedexcli/internal/render"
+)
+
+// nationalDex is the pokedex that lists every Pokemon.
+const nationalDex = "national"
+
+// dexWorkers is the number of pokedexes fetched in parallel.
+const dexWorkers = 8
+
+// Icons marking whether a pokedex entry was caught or only seen.
+const (
+	caughtIcon = "●"
+	seenIcon   = "○"
+	unseenIcon = "·"
+)
+
+// caughtNumbers returns the national dex numbers of the caught Pokemon.
+func caughtNumbers(cfg *config) map[int]bool {
+	caught := map[int]bool{}
+	for _, pokemon := range cfg.caughtPokemon {
+		caught[pokemon.ID] = true
+	}
+	return caught
+}
+
+// dexNumbers returns the national dex numbers of the species in a pokedex.
+func dexNumbers(dex pokeapi.Pokedex) []int {
+	numbers := []int{}
+	for _, entry := range dex.PokemonEntries {
+		numbers = append(numbers, pokeapi.ResourceID(entry.PokemonSpecies.URL))
+	}
+	return numbers
+}
+
+/*
+pokedexScope returns the pokedexes a pokedex listing covers. Without a region
+these are the national dex and every regional main-series dex; with a region,
+only the dexes of that region.
+*/
+func pokedexScope(cfg *config, region string) ([]pokeapi.Pokedex, error) {
+	if region == "" {
+		return allPokedexes(cfg)
+	}
+
+	r, err := cfg.pokeapiClient.GetRegion(region)
+	if err != nil {
+		return nil, notFoundError(cfg, pokeapi.EndpointRegion, region, err)
+	}
+	if len(r.Pokedexes) == 0 {
+		return nil, messages.Errorf("%s has no pokedex", r.Name)
+	}
+	names := []string{}
+	for _, ref := range r.Pokedexes {
+		names = append(names, ref.Name)
+	}
+	return fetchPokedexes(cfg, names)
+}
+
+/*
+allPokedexes returns the national dex and every regional main-series dex.
+Finding them takes a request per pokedex, so they are loaded the first time
+they are needed and kept for the session.
+*/
+func allPokedexes(cfg *config) ([]pokeapi.Pokedex, error) {
+	if cfg.pokedexes != nil {
+		return cfg.pokedexes, nil
+	}
+
+	names := []string{}
+	for ref, err := range cfg.pokeapiClient.All(pokeapi.EndpointPokedex, pokeapi.IterOptions{}) {
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, ref.Name)
+	}
+	fetched, err := fetchPokedexes(cfg, names)
+	if err != nil {
+		return nil, err
+	}
+
+	dexes := []pokeapi.Pokedex{}
+	for _, dex := range fetched {
+		if dex.Name != nationalDex && (!dex.IsMainSeries || dex.Region == nil) {
+			continue
+		}
+		dexes = append(dexes, dex)
+	}
+	cfg.pokedexes = dexes
+	return dexes, nil
+}
+
+// fetchPokedexes fetches several pokedexes in parallel, keeping their order.
+func fetchPokedexes(cfg *config, names []string) ([]pokeapi.Pokedex, error) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+	dexes := make([]pokeapi.Pokedex, len(names))
+	sem := make(chan struct{}, dexWorkers)
+	for i, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			dex, err := cfg.pokeapiClient.GetPokedex(name)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				return
+			}
+			dexes[i] = dex
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return dexes, nil
+}
+
+/*
+pokedexEntries lists the Pokemon shown by the pokedex command, sorted by
+national dex number. Normally these are the Pokemon seen or caught; with
+missing, they are the Pokemon of the pokedexes that weren't caught yet.
+Only the national dex numbers in inScope are listed, unless it is nil.
+*/
+func pokedexEntries(cfg *config, dexes []pokeapi.Pokedex, inScope map[int]bool, missing bool) []pokedexRecord {
+	caught := caughtNumbers(cfg)
+	records := []pokedexRecord{}
+	listed := map[string]bool{}
+	add := func(record pokedexRecord) {
+		if listed[record.Name] || (inScope != nil && !inScope[record.ID]) {
+			return
+		}
+		listed[record.Name] = true
+		records = append(records, record)
+	}
+
+	if missing {
+		for _, dex := range dexes {
+			for _, entry := range dex.PokemonEntries {
+				number := pokeapi.ResourceID(entry.PokemonSpecies.URL)
+				if caught[number] {
+					continue
+				}
+				add(pokedexRecord{ID: number, Name: entry.PokemonSpecies.Name, Seen: cfg.pokedex.HasSeen(number)})
+			}
+		}
+	} else {
+		for name, pokemon := range cfg.caughtPokemon {
+			add(pokedexRecord{ID: pokemon.ID, Name: name, Seen: true, Caught: true, Owned: cfg.storage.Count(name)})
+		}
+		for name, number := range cfg.pokedex.Seen {
+			add(pokedexRecord{ID: number, Name: name, Seen: true, Caught: caught[number]})
+		}
+	}
+
+	slices.SortFunc(records, func(a, b pokedexRecord) int {
+		return cmp.Or(cmp.Compare(a.ID, b.ID), cmp.Compare(a.Name, b.Name))
+	})
+	return records
+}
+
+// pokedexSorts are the orders the pokedex command can list caught Pokemon in.
+var pokedexSorts = []string{"id", "name", "height", "weight", "bst", "caught-at"}
+
+// pokedexQuery holds the filter and sort options of the pokedex command.
+// They are checked against the data of caught Pokemon, so using any of them
+// lists caught Pokemon only.
+type pokedexQuery struct {
+	typeName string
+	minStats game.Stats // stat name, or "bst" for the base stat total, to minimum
+	sort     string
+	desc     bool
+	limit    int
+}
+
+/*
+parsePokedexQuery reads the filter and sort options given to the pokedex
+command. --min-stat takes comma-separated stat=value pairs, e.g.
+"speed=100,attack=80".
+*/
+func parsePokedexQuery(flags flagSet) (pokedexQuery, error) {
+	q := pokedexQuery{
+		typeName: toSlug(flags.get("type")),
+		minStats: game.Stats{},
+		sort:     toSlug(flags.get("sort")),
+		desc:     flags.has("desc"),
+	}
+	if q.sort != "" && !slices.Contains(pokedexSorts, q.sort) {
+		return pokedexQuery{}, messages.Errorf("can't sort by %q. choose one of: %s", q.sort, strings.Join(pokedexSorts, ", "))
+	}
+	limit, err := flags.getInt("limit", 0)
+	if err != nil {
+		return pokedexQuery{}, err
+	}
+	if flags.has("limit") && limit < 1 {
+		return pokedexQuery{}, messages.Errorf("the limit must be at least 1")
+	}
+	q.limit = limit
+
+	if flags.has("min-stat") {
+		for _, pair := range strings.Split(flags.get("min-stat"), ",") {
+			stat, value, ok := strings.Cut(pair, "=")
+			stat = toSlug(stat)
+			if !ok || (stat != "bst" && !slices.Contains(game.StatNames, stat)) {
+				return pokedexQuery{}, messages.Errorf("--min-stat takes stat=value pairs, like speed=100. stats are: %s, bst", strings.Join(game.StatNames, ", "))
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return pokedexQuery{}, messages.Errorf("the minimum %s must be a number, got %q", stat, value)
+			}
+			q.minStats[stat] = n
+		}
+	}
+	return q, nil
+}
+
+// active reports whether any filter or sort option was given.
+func (q pokedexQuery) active() bool {
+	return q.typeName != "" || len(q.minStats) > 0 || q.sort != "" || q.desc || q.limit > 0
+}
+
+// matches reports whether a caught Pokemon passes the filters, with the types
+// it has in the given generation.
+func (q pokedexQuery) matches(pokemon pokeapi.Pokemon, generation string) bool {
+	if q.typeName != "" && !slices.Contains(game.TypesIn(pokemon, generation), q.typeName) {
+		return false
+	}
+	stats := game.BaseStats(pokemon)
+	stats["bst"] = stats.Total()
+	for stat, minimum := range q.minStats {
+		if stats[stat] < minimum {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+apply keeps the caught Pokemon that pass the filters, sorts them and cuts the
+list to the limit. Ties are broken by national dex number.
+*/
+func (q pokedexQuery) apply(cfg *config, records []pokedexRecord) []pokedexRecord {
+	records = slices.DeleteFunc(records, func(r pokedexRecord) bool {
+		pokemon, ok := cfg.caughtPokemon[r.Name]
+		return !ok || !q.matches(pokemon, cfg.game.generation)
+	})
+
+	caughtAt := map[string]time.Time{}
+	for _, p := range cfg.storage.All() {
+		if first, ok := caughtAt[p.Species]; !ok || p.CaughtOn.Before(first) {
+			caughtAt[p.Species] = p.CaughtOn
+		}
+	}
+	key := func(r pokedexRecord) int {
+		pokemon := cfg.caughtPokemon[r.Name]
+		switch q.sort {
+		case "height":
+			return pokemon.Height
+		case "weight":
+			return pokemon.Weight
+		case "bst":
+			return game.BaseStats(pokemon).Total()
+		}
+		return r.ID
+	}
+	slices.SortStableFunc(records, func(a, b pokedexRecord) int {
+		var c int
+		switch q.sort {
+		case "name":
+			c = cmp.Compare(a.Name, b.Name)
+		case "caught-at":
+			// Pokemon that were caught but are no longer owned go last.
+			ta, oka := caughtAt[a.Name]
+			tb, okb := caughtAt[b.Name]
+			if oka != okb {
+				if oka {
+					return -1
+				}
+				return 1
+			}
+			c = ta.Compare(tb)
+		default:
+			c = cmp.Compare(key(a), key(b))
+		}
+		if q.desc {
+			c = -c
+		}
+		return cmp.Or(c, cmp.Compare(a.ID, b.ID))
+	})
+
+	if q.limit > 0 && len(records) > q.limit {
+		records = records[:q.limit]
+	}
+	return records
+}
+
+/*
+commandPokedex lists the Pokemon the player has seen and caught by national
+dex number, followed by how complete the national and regional pokedexes are.
+--region limits both to the pokedexes of a region, and --missing lists the
+Pokemon that haven't been caught yet instead. The caught Pokemon can also be
+filtered by type and minimum stats and sorted, see pokedexQuery.
+*/
+func commandPokedex(cfg *config, flags flagSet, args ...string) error {
+	if len(args) > 0 {
+		return messages.Errorf("usage: pokedex [--region <region>] [--missing] [--type <type>] [--min-stat <stat=value>] [--sort <order>] [--desc] [--limit N]")
+	}
+	region := toSlug(flags.get("region"))
+	missing := flags.has("missing")
+	query, err := parsePokedexQuery(flags)
+	if err != nil {
+		return err
+	}
+	if missing && query.active() {
+		return messages.Errorf("--missing lists Pokemon you haven't caught, so it can't be filtered or sorted")
+	}
+	if !missing && len(cfg.caughtPokemon) == 0 && len(cfg.pokedex.Seen) == 0 {
+		return messages.Errorf("your pokedex is empty. go explore and catch some pokemon")
+	}
+
+	dexes, err := pokedexScope(cfg, region)
+	if err != nil {
+		return err
+	}
+	var inScope map[int]bool
+	if region != "" {
+		inScope = map[int]bool{}
+		for _, dex := range dexes {
+			for _, number := range dexNumbers(dex) {
+				inScope[number] = true
+			}
+		}
+	}
+	records := pokedexEntries(cfg, dexes, inScope, missing)
+	if query.active() {
+		records = query.apply(cfg, records)
+	}
+
+	return printRecords(cfg, records, func() {
+		if len(records) == 0 {
+			switch {
+			case missing:
+				fmt.Println(cfg.msg.Sprintf("You caught every Pokemon in it. Congratulations!"))
+			case query.active():
+				fmt.Println(cfg.msg.Sprintf("None of your caught Pokemon match."))
+			default:
+				cfg.msg.Printf("You haven't seen any Pokemon from %s yet.\n", region)
+			}
+		} else {
+			// Only the Pokemon the player knows are named in their language,
+			// which keeps long lists of missing ones from fetching every species.
+			known := []string{}
+			for _, entry := range records {
+				if entry.Seen || entry.Caught {
+					known = append(known, entry.Name)
+				}
+			}
+			prefetchNames(cfg, pokeapi.EndpointPokemonSpecies, known)
+
+			rows := [][]string{}
+			for _, entry := range records {
+				name, types, owned := entry.Name, "", ""
+				if entry.Seen || entry.Caught {
+					name = pokemonName(cfg, entry.Name)
+				}
+				if pokemon, ok := cfg.caughtPokemon[entry.Name]; ok {
+					types = typeBadges(cfg, gameTypes(cfg, pokemon))
+					owned = strconv.Itoa(entry.Owned)
+				}
+				rows = append(rows, []string{fmt.Sprintf("#%d", entry.ID), pokedexIcon(cfg, entry), name, types, owned})
+			}
+			cfg.ui.Table([]string{cfg.msg.Sprintf("No."), "", cfg.msg.Sprintf("Name"), cfg.msg.Sprintf("Types"), cfg.msg.Sprintf("Owned")}, rows)
+		}
+		fmt.Println()
+		printCompletion(cfg, dexes, region != "")
+	})
+}
+
+// pokedexIcon marks an entry as caught, seen or not seen yet.
+func pokedexIcon(cfg *config, entry pokedexRecord) string {
+	switch {
+	case entry.Caught:
+		return cfg.ui.Colorize(render.Red, caughtIcon)
+	case entry.Seen:
+		return seenIcon
+	default:
+		return cfg.ui.Colorize(render.Gray, unseenIcon)
+	}
+}
+
+/*
+printCompletion prints how many Pokemon of each pokedex were seen and caught.
+Regional dexes without a single Pokemon seen are left out unless all is set;
+the national dex is always shown.
+*/
+func printCompletion(cfg *config, dexes []pokeapi.Pokedex, all bool) {
+	caught := caughtNumbers(cfg)
+	rows := [][]string{}
+	for _, dex := range dexes {
+		c := cfg.pokedex.Completion(dexNumbers(dex), caught)
+		if !all && c.Seen == 0 && dex.Name != nationalDex {
+			continue
+		}
+		rows = append(rows, []string{
+			dex.Name,
+			fmt.Sprintf("%d/%d", c.Seen, c.Total),
+			fmt.Sprintf("%d/%d", c.Caught, c.Total),
+			fmt.Sprintf("%.1f%%", c.Percent()),
+		})
+	}
+	cfg.ui.Table([]string{cfg.msg.Sprintf("Pokedex"), cfg.msg.Sprintf("Seen"), cfg.msg.Sprintf("Caught"), cfg.msg.Sprintf("Complete")}, rows)
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// testPokedexPokemon builds the PokeAPI data of a Pokemon with one type and
+// the given height, weight, speed and attack. Its other base stats are 50.
+func testPokedexPokemon(t *testing.T, id int, name, typeName string, height, weight, speed, attack int) pokeapi.Pokemon {
+	t.Helper()
+	data := fmt.Sprintf(`{
+		"id": %d, "name": %q, "height": %d, "weight": %d,
+		"types": [{"slot": 1, "type": {"name": %q}}],
+		"stats": [
+			{"base_stat": 50, "stat": {"name": "hp"}},
+			{"base_stat": %d, "stat": {"name": "attack"}},
+			{"base_stat": 50, "stat": {"name": "defense"}},
+			{"base_stat": 50, "stat": {"name": "special-attack"}},
+			{"base_stat": 50, "stat": {"name": "special-defense"}},
+			{"base_stat": %d, "stat": {"name": "speed"}}
+		]
+	}`, id, name, height, weight, typeName, attack, speed)
+	pokemon := pokeapi.Pokemon{}
+	if err := json.Unmarshal([]byte(data), &pokemon); err != nil {
+		t.Fatal(err)
+	}
+	return pokemon
+}
+
+func TestPokedexQuery(t *testing.T) {
+	cfg := &config{
+		caughtPokemon: map[string]pokeapi.Pokemon{
+			"squirtle": testPokedexPokemon(t, 7, "squirtle", "water", 5, 90, 43, 48),
+			"staryu":   testPokedexPokemon(t, 120, "staryu", "water", 8, 345, 85, 45),
+			"starmie":  testPokedexPokemon(t, 121, "starmie", "water", 11, 800, 115, 75),
+			"pikachu":  testPokedexPokemon(t, 25, "pikachu", "electric", 4, 60, 90, 55),
+		},
+		storage: game.NewStorage(),
+	}
+	caught := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	for i, species := range []string{"starmie", "pikachu", "squirtle"} {
+		if _, err := cfg.storage.Add(&game.Pokemon{Species: species, CaughtOn: caught.Add(time.Duration(i) * time.Hour)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	records := []pokedexRecord{}
+	for name, pokemon := range cfg.caughtPokemon {
+		records = append(records, pokedexRecord{ID: pokemon.ID, Name: name, Seen: true, Caught: true})
+	}
+	// A Pokemon that was only seen has no data to filter on.
+	records = append(records, pokedexRecord{ID: 1, Name: "bulbasaur", Seen: true})
+
+	cases := []struct {
+		flags    flagSet
+		expected []string
+	}{
+		{flags: flagSet{"sort": "id"}, expected: []string{"squirtle", "pikachu", "staryu", "starmie"}},
+		{flags: flagSet{"type": "water", "sort": "weight", "desc": ""}, expected: []string{"starmie", "staryu", "squirtle"}},
+		{flags: flagSet{"min-stat": "speed=85"}, expected: []string{"pikachu", "staryu", "starmie"}},
+		{flags: flagSet{"min-stat": "speed=85,attack=50"}, expected: []string{"pikachu", "starmie"}},
+		{flags: flagSet{"min-stat": "bst=300", "sort": "bst"}, expected: []string{"staryu", "pikachu", "starmie"}},
+		{flags: flagSet{"sort": "name", "limit": "2"}, expected: []string{"pikachu", "squirtle"}},
+		{flags: flagSet{"sort": "height"}, expected: []string{"pikachu", "squirtle", "staryu", "starmie"}},
+		// staryu isn't owned anymore, so it goes last.
+		{flags: flagSet{"sort": "caught-at"}, expected: []string{"starmie", "pikachu", "squirtle", "staryu"}},
+	}
+	for _, c := range cases {
+		q, err := parsePokedexQuery(c.flags)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", c.flags, err)
+		}
+		names := []string{}
+		for _, r := range q.apply(cfg, slices.Clone(records)) {
+			names = append(names, r.Name)
+		}
+		if !slices.Equal(names, c.expected) {
+			t.Errorf("%v: expected %v, got %v", c.flags, c.expected, names)
+		}
+	}
+
+	// Clefairy was a normal type before fairy types were added in generation VI.
+	clefairy := testPokedexPokemon(t, 35, "clefairy", "fairy", 6, 75, 35, 45)
+	if err := json.Unmarshal([]byte(`{"past_types": [{"generation": {"name": "generation-v"}, "types": [{"slot": 1, "type": {"name": "normal"}}]}]}`), &clefairy); err != nil {
+		t.Fatal(err)
+	}
+	cfg.caughtPokemon["clefairy"] = clefairy
+	clefairyRecord := []pokedexRecord{{ID: 35, Name: "clefairy", Seen: true, Caught: true}}
+	for _, c := range []struct{ generation, typeName string }{{"", "fairy"}, {"generation-i", "normal"}, {"generation-vi", "fairy"}} {
+		cfg.game = gameVersion{generation: c.generation}
+		for _, typeName := range []string{"fairy", "normal"} {
+			got := len(pokedexQuery{typeName: typeName}.apply(cfg, slices.Clone(clefairyRecord))) == 1
+			if expected := typeName == c.typeName; got != expected {
+				t.Errorf("--type %s in %q: expected a match %v, got %v", typeName, c.generation, expected, got)
+			}
+		}
+	}
+
+	for _, flags := range []flagSet{{"sort": "color"}, {"min-stat": "speed"}, {"min-stat": "luck=5"}, {"min-stat": "speed=fast"}, {"limit": "0"}} {
+		if _, err := parsePokedexQuery(flags); err == nil {
+			t.Errorf("%v: expected an error", flags)
+		}
+	}
+}
+
+// inFlightTransport delays each request a little and records how many were
+// in flight at once.
+type inFlightTransport struct {
+	next     http.RoundTripper
+	mu       sync.Mutex
+	inFlight int
+	most     int
+}
+
+func (t *inFlightTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.inFlight++
+	t.most = max(t.most, t.inFlight)
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		t.inFlight--
+		t.mu.Unlock()
+	}()
+	time.Sleep(10 * time.Millisecond)
+	return t.next.RoundTrip(req)
+}
+
+func TestAllPokedexes(t *testing.T) {
+	fixtures := map[string]string{
+		"pokedex/national":         `{"name": "national", "is_main_series": true}`,
+		"pokedex/conquest-gallery": `{"name": "conquest-gallery", "is_main_series": false}`,
+	}
+	names := []string{"national", "conquest-gallery"}
+	expected := []string{"national"}
+	for i := range 12 {
+		name := fmt.Sprintf("region-%d", i)
+		fixtures["pokedex/"+name] = fmt.Sprintf(`{"name": %q, "is_main_series": true, "region": {"name": %q}}`, name, name)
+		names = append(names, name)
+		expected = append(expected, name)
+	}
+	list := pokeapi.ResourceList{Count: len(names)}
+	for _, name := range names {
+		list.Results = append(list.Results, pokeapi.Resource{Name: name})
+	}
+	page, err := json.Marshal(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixtures["pokedex?offset=0&limit=20"] = string(page)
+
+	api := &fakeAPI{responses: fixtures}
+	cfg := newFakeConfig(api)
+	transport := &inFlightTransport{next: api}
+	cfg.pokeapiClient.SetTransport(transport)
+
+	dexes, err := allPokedexes(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []string{}
+	for _, dex := range dexes {
+		got = append(got, dex.Name)
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if transport.most < 2 || transport.most > dexWorkers {
+		t.Errorf("expected between 2 and %d requests at once, got %d", dexWorkers, transport.most)
+	}
+
+	requests := len(api.requested())
+	if _, err := allPokedexes(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.requested()) != requests {
+		t.Errorf("expected the pokedexes to be kept, got requests %v", api.requested()[requests:])
+	}
+
+	// A pokedex that can't be fetched fails the listing, and nothing is kept.
+	fixtures["pokedex/region-5"] = failRequest
+	cfg = newFakeConfig(&fakeAPI{responses: fixtures})
+	if _, err := allPokedexes(cfg); err == nil {
+		t.Error("expected an error")
+	}
+	if cfg.pokedexes != nil {
+		t.Errorf("expected no pokedexes to be kept, got %v", cfg.pokedexes)
+	}
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/output"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// locationRecord is the structured form of a location-area listed by map.
+type locationRecord struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// regionRecord is the structured form of a region shown by the region command.
+type regionRecord struct {
+	Name          string   `json:"name"`
+	Generation    string   `json:"generation"`
+	VersionGroups []string `json:"version_groups"`
+	Pokedexes     []string `json:"pokedexes"`
+	Locations     int      `json:"locations"`
+}
+
+// encounterRecord is the structured form of a Pokemon found by explore.
+type encounterRecord struct {
+	Location string `json:"location"`
+	Pokemon  string `json:"pokemon"`
+	URL      string `json:"url"`
+}
+
+// encounterDetailRecord is the structured form of an encounter shown by explore --details.
+type encounterDetailRecord struct {
+	Location string `json:"location"`
+	Pokemon  string `json:"pokemon"`
+	Version  string `json:"version"`
+	Method   string `json:"method"`
+	MinLevel int    `json:"min_level"`
+	MaxLevel int    `json:"max_level"`
+	Chance   int    `json:"chance"`
+}
+
+// newEncounterDetailRecord builds an encounterDetailRecord from an encounter slot.
+func newEncounterDetailRecord(location string, slot encounterSlot) encounterDetailRecord {
+	return encounterDetailRecord{
+		Location: location,
+		Pokemon:  slot.pokemon,
+		Version:  slot.version,
+		Method:   slot.method,
+		MinLevel: slot.minLevel,
+		MaxLevel: slot.maxLevel,
+		Chance:   slot.chance,
+	}
+}
+
+// pokemonRecord is the structured form of a Pokemon shown by inspect.
+type pokemonRecord struct {
+	ID              int         `json:"id"`
+	Name            string      `json:"name"`
+	Height          int         `json:"height"`
+	Weight          int         `json:"weight"`
+	BaseExperience  int         `json:"base_experience"`
+	Types           []string    `json:"types"`
+	Abilities       []string    `json:"abilities"`
+	HiddenAbilities []string    `json:"hidden_abilities"`
+	Stats           statsRecord `json:"stats"`
+}
+
+// compareRecord is the structured form of a Pokemon shown by compare.
+type compareRecord struct {
+	pokemonRecord
+	Total    int                `json:"total"`
+	Matchups map[string]float64 `json:"matchups"`
+}
+
+// statsRecord holds a Pokemon's base stats.
+type statsRecord struct {
+	HP             int `json:"hp"`
+	Attack         int `json:"attack"`
+	Defense        int `json:"defense"`
+	SpecialAttack  int `json:"special-attack"`
+	SpecialDefense int `json:"special-defense"`
+	Speed          int `json:"speed"`
+}
+
+// pokedexRecord is the structured form of a pokedex entry.
+type pokedexRecord struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Seen   bool   `json:"seen"`
+	Caught bool   `json:"caught"`
+	Owned  int    `json:"owned"`
+}
+
+// individualRecord is the structured form of an owned Pokemon shown by inspect.
+type individualRecord struct {
+	ID              int         `json:"id"`
+	Name            string      `json:"name"`
+	Species         string      `json:"species"`
+	Level           int         `json:"level"`
+	Nature          string      `json:"nature"`
+	Types           []string    `json:"types"`
+	Abilities       []string    `json:"abilities"`
+	HiddenAbilities []string    `json:"hidden_abilities"`
+	BaseStats       statsRecord `json:"base_stats"`
+	IVs             statsRecord `json:"ivs"`
+	EVs             statsRecord `json:"evs"`
+	Stats           statsRecord `json:"stats"`
+}
+
+// moveRecord is the structured form of a move shown by the move command.
+type moveRecord struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	DamageClass   string `json:"damage_class"`
+	Power         *int   `json:"power"`
+	Accuracy      *int   `json:"accuracy"`
+	PP            *int   `json:"pp"`
+	Priority      int    `json:"priority"`
+	Target        string `json:"target"`
+	Effect        string `json:"effect"`
+	FlavorText    string `json:"flavor_text"`
+	Ailment       string `json:"ailment"`
+	AilmentChance int    `json:"ailment_chance"`
+	CritRate      int    `json:"crit_rate"`
+	Drain         int    `json:"drain"`
+	Healing       int    `json:"healing"`
+	FlinchChance  int    `json:"flinch_chance"`
+}
+
+// learnsetRecord is the structured form of a move listed by the moves command.
+type learnsetRecord struct {
+	Level         int      `json:"level"`
+	Move          string   `json:"move"`
+	Method        string   `json:"method"`
+	VersionGroups []string `json:"version_groups"`
+}
+
+// abilityRecord is the structured form of an ability shown by the ability command.
+type abilityRecord struct {
+	ID          int                    `json:"id"`
+	Name        string                 `json:"name"`
+	Generation  string                 `json:"generation"`
+	ShortEffect string                 `json:"short_effect"`
+	Effect      string                 `json:"effect"`
+	FlavorText  string                 `json:"flavor_text"`
+	Pokemon     []abilityPokemonRecord `json:"pokemon"`
+}
+
+// abilityPokemonRecord is a Pokemon that can have an ability.
+type abilityPokemonRecord struct {
+	Name   string `json:"name"`
+	Hidden bool   `json:"hidden"`
+}
+
+// bagRecord is the structured form of an item in the bag.
+type bagRecord struct {
+	Item     string `json:"item"`
+	Quantity int    `json:"quantity"`
+	Category string `json:"category"`
+}
+
+// shopRecord is the structured form of an item sold at a Poke Mart.
+type shopRecord struct {
+	Item  string `json:"item"`
+	Price int    `json:"price"`
+	InBag int    `json:"in_bag"`
+}
+
+// plotRecord is the structured form of a berry planted in a soil plot.
+type plotRecord struct {
+	Location  string    `json:"location"`
+	Berry     string    `json:"berry"`
+	Stage     string    `json:"stage"`
+	PlantedAt time.Time `json:"planted_at"`
+	RipeAt    time.Time `json:"ripe_at"`
+}
+
+// itemRecord is the structured form of an item shown by the item command.
+type itemRecord struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Category   string `json:"category"`
+	Cost       int    `json:"cost"`
+	FlingPower *int   `json:"fling_power"`
+	Effect     string `json:"effect"`
+	FlavorText string `json:"flavor_text"`
+	InBag      int    `json:"in_bag"`
+}
+
+// searchRecord is the structured form of a name found by search.
+type searchRecord struct {
+	Kind  string  `json:"kind"`
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
+// newPokemonRecord builds a pokemonRecord from the PokeAPI data, with the
+// Pokemon's types in a generation ("" for the current ones).
+func newPokemonRecord(pokemon pokeapi.Pokemon, generation string) pokemonRecord {
+	record := pokemonRecord{
+		ID:             pokemon.ID,
+		Name:           pokemon.Name,
+		Height:         pokemon.Height,
+		Weight:         pokemon.Weight,
+		BaseExperience: pokemon.BaseExperience,
+		Types:          game.TypesIn(pokemon, generation),
+	}
+	record.Abilities, record.HiddenAbilities = pokemonAbilities(pokemon)
+	record.Stats = newStatsRecord(game.BaseStats(pokemon))
+	return record
+}
+
+// newMoveRecord builds a moveRecord from the PokeAPI data, with its effect in the given language.
+func newMoveRecord(move pokeapi.Move, language string) moveRecord {
+	record := moveRecord{
+		ID:          move.ID,
+		Name:        move.Name,
+		Type:        move.Type.Name,
+		DamageClass: move.DamageClass.Name,
+		Power:       move.Power,
+		Accuracy:    move.Accuracy,
+		PP:          move.PP,
+		Priority:    move.Priority,
+		Target:      move.Target.Name,
+		Effect:      moveEffect(move, language),
+	}
+	if meta := move.Meta; meta != nil {
+		record.Ailment = meta.Ailment.Name
+		record.AilmentChance = meta.AilmentChance
+		record.CritRate = meta.CritRate
+		record.Drain = meta.Drain
+		record.Healing = meta.Healing
+		record.FlinchChance = meta.FlinchChance
+	}
+	return record
+}
+
+// newIndividualRecord builds an individualRecord for an owned Pokemon of the
+// given species, with its types in a generation ("" for the current ones).
+func newIndividualRecord(p *game.Pokemon, species pokeapi.Pokemon, generation string) individualRecord {
+	record := individualRecord{
+		ID:        p.ID,
+		Name:      p.Name(),
+		Species:   p.Species,
+		Level:     p.Level,
+		Nature:    p.Nature.Name,
+		Types:     game.TypesIn(species, generation),
+		BaseStats: newStatsRecord(game.BaseStats(species)),
+		IVs:       newStatsRecord(p.IVs),
+		EVs:       newStatsRecord(p.EVs),
+		Stats:     newStatsRecord(p.Stats(species)),
+	}
+	record.Abilities, record.HiddenAbilities = pokemonAbilities(species)
+	return record
+}
+
+// newStatsRecord builds a statsRecord from stats keyed by name.
+func newStatsRecord(stats game.Stats) statsRecord {
+	return statsRecord{
+		HP:             stats["hp"],
+		Attack:         stats["attack"],
+		Defense:        stats["defense"],
+		SpecialAttack:  stats["special-attack"],
+		SpecialDefense: stats["special-defense"],
+		Speed:          stats["speed"],
+	}
+}
+
+/*
+printRecords writes the records in the configured output format.
+For the table format the printTable function is called instead, so each
+command keeps its human-readable layout.
+*/
+func printRecords(cfg *config, records any, printTable func()) error {
+	if cfg.output == output.Table || cfg.output == "" {
+		printTable()
+		return nil
+	}
+	return output.Write(os.Stdout, cfg.output, records)
+}
@@ -3,25 +3,54 @@ package main
 import (
 	"bufio"
 	"fmt"
+	"math/rand"
 	"os"
+	"strconv"
 	"strings"
+	"unicode"
 
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/output"
 	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+	"github.com/OferRavid/pokedexcli/internal/render"
+	"github.com/OferRavid/pokedexcli/internal/search"
 )
 
 type config struct {
-	caughtPokemon        map[string]pokeapi.Pokemon
-	caughtPokemonCount   map[string]int
-	areaExplored         []string
-	pokeapiClient        pokeapi.Client
-	NextLocationsURL     *string `json:"next"`
-	PreviousLocationsURL *string `json:"previous"`
+	caughtPokemon    map[string]pokeapi.Pokemon
+	storage          *game.Storage // the Pokemon the player owns
+	bag              game.Bag      // the player's items
+	money            int
+	farm             *game.Farm        // the berries the player has planted
+	pokedex          *game.Pokedex     // the Pokemon the player has seen
+	pokedexes        []pokeapi.Pokedex // the national and regional pokedexes, nil until first listed
+	savePath         string            // the file progress is saved in, "" to not save
+	unloadedCaught   []string          // caught Pokemon whose data couldn't be fetched when the game was loaded
+	locationExplored string
+	areasExplored    []pokeapi.LocationArea
+	wildPokemon      *wildPokemon // the Pokemon that can be caught, nil when none was encountered
+	rng              *rand.Rand
+	input            *bufio.Scanner // the player's input, read by the REPL and by prompts
+	pokeapiClient    pokeapi.Client
+	output           output.Format
+	mode             string            // gameMode or referenceMode
+	game             gameVersion       // the game the data shown is limited to
+	language         string            // the PokeAPI code of the language names are shown in
+	msg              messages.Printer  // the CLI's messages in that language
+	localNames       map[string]string // names in the chosen language by endpoint and identifier
+	ui               *render.Renderer
+	nameIndexes      map[string]*search.Index // search indexes by PokeAPI endpoint
+	locationsOffset  *int                     // offset of the page last shown by map, nil before the first page
+	locationsLimit   int
+	locationsCount   int // total number of location areas, 0 until a page is loaded
 }
 
 type cliCommand struct {
 	name        string
-	description string
-	callback    func(*config, ...string) error
+	description messages.Text
+	flags       map[string]bool // accepted flags; true if the flag takes a value
+	callback    func(*config, flagSet, ...string) error
 }
 
 /*
@@ -41,80 +70,414 @@ func getCommands() map[string]cliCommand {
 			callback:    commandExit,
 		},
 		"map": {
-			name:        "map",
-			description: "Displays the next batch of 20 location-areas",
+			name:        "map [page|first|last] [--limit N]",
+			description: "Displays the next page of location-areas, or jumps to the given page",
+			flags:       map[string]bool{"limit": true},
 			callback:    commandMap,
 		},
 		"mapb": {
 			name:        "mapb",
-			description: "Displays the previous batch of 20 location-areas",
+			description: "Displays the previous page of location-areas",
 			callback:    commandMapb,
 		},
 		"explore": {
-			name:        "explore <location_name>",
-			description: "Displays all Pokemon in the area given",
+			name:        "explore <location_name> [--details] [--version <game>] [--method <method>]",
+			description: "Displays all Pokemon in the area given, or in every area of a location",
+			flags:       map[string]bool{"details": false, "version": true, "method": true},
 			callback:    commandExplore,
 		},
+		"where": {
+			name:        "where <pokemon_name> [--version <game>] [--method <method>]",
+			description: "Lists the location areas where a Pokemon can be found",
+			flags:       map[string]bool{"version": true, "method": true},
+			callback:    commandWhere,
+		},
+		"version": {
+			name:        "version [<game>|all]",
+			description: "Limits encounters, moves, types and descriptions to one game, e.g. version diamond",
+			callback:    commandVersion,
+		},
+		"language": {
+			name:        "language [<code>]",
+			description: "Shows names and messages in a language, e.g. language es",
+			callback:    commandLanguage,
+		},
+		"regions": {
+			name:        "regions",
+			description: "Lists all regions",
+			callback:    commandRegions,
+		},
+		"region": {
+			name:        "region <region_name>",
+			description: "Shows details about a region",
+			callback:    commandRegion,
+		},
+		"locations": {
+			name:        "locations <region_name>",
+			description: "Lists the locations in a region",
+			callback:    commandLocations,
+		},
+		"encounter": {
+			name:        "encounter [walk|surf|fish <rod>]",
+			description: "Looks for a wild Pokemon in the explored area",
+			callback:    commandEncounter,
+		},
 		"catch": {
-			name:        "catch <pokemon_name>",
-			description: "Attempts to catch a Pokemon encountered in an area",
+			name:        "catch [pokemon_name] [--ball <ball>]",
+			description: "Throws a Poke Ball, or the ball given, at the wild Pokemon you encountered",
+			flags:       map[string]bool{"ball": true},
 			callback:    commandCatch,
 		},
 		"inspect": {
-			name:        "inspect <pokemon_name>",
-			description: "Shows details about a caught Pokemon",
+			name:        "inspect <pokemon_name> [--any]",
+			description: "Shows details about a caught Pokemon, or any Pokemon with --any",
+			flags:       map[string]bool{"any": false},
 			callback:    commandInspect,
 		},
+		"lookup": {
+			name:        "lookup <pokemon_name>",
+			description: "Shows details about any Pokemon, caught or not",
+			callback:    commandLookup,
+		},
 		"pokedex": {
-			name:        "pokedex",
-			description: "Displays all the Pokemon you caught",
+			name:        "pokedex [--region <region>] [--missing] [--type <type>] [--min-stat <stat=value>] [--sort id|name|height|weight|bst|caught-at] [--desc] [--limit N]",
+			description: "Lists the Pokemon you have seen and caught, and how complete your pokedex is",
+			flags:       map[string]bool{"region": true, "missing": false, "type": true, "min-stat": true, "sort": true, "desc": false, "limit": true},
 			callback:    commandPokedex,
 		},
+		"compare": {
+			name:        "compare <pokemon> <pokemon> [pokemon...] [--any]",
+			description: "Shows Pokemon side by side: stats, types, abilities, size and type matchups",
+			flags:       map[string]bool{"any": false},
+			callback:    commandCompare,
+		},
+		"move": {
+			name:        "move <move_name>",
+			description: "Shows a move's type, power, accuracy, PP and effect",
+			callback:    commandMove,
+		},
+		"moves": {
+			name:        "moves <pokemon_name> [--method level-up|machine|egg|tutor] [--version-group <group>]",
+			description: "Lists the moves a Pokemon can learn",
+			flags:       map[string]bool{"method": true, "version-group": true},
+			callback:    commandMoves,
+		},
+		"ability": {
+			name:        "ability <ability_name>",
+			description: "Shows what an ability does and which Pokemon can have it",
+			callback:    commandAbility,
+		},
+		"search": {
+			name:        "search <term> [--kind pokemon|location] [--limit N]",
+			description: "Finds Pokemon and location-areas with names similar to the term",
+			flags:       map[string]bool{"kind": true, "limit": true},
+			callback:    commandSearch,
+		},
+		"party": {
+			name:        "party",
+			description: "Displays the Pokemon in your party",
+			callback:    commandParty,
+		},
+		"box": {
+			name:        "box [number]",
+			description: "Displays the Pokemon in a PC box, or how full the boxes are",
+			callback:    commandBox,
+		},
+		"deposit": {
+			name:        "deposit <pokemon> [box]",
+			description: "Moves a Pokemon from your party into a PC box",
+			callback:    commandDeposit,
+		},
+		"withdraw": {
+			name:        "withdraw <pokemon>",
+			description: "Moves a Pokemon from a PC box into your party",
+			callback:    commandWithdraw,
+		},
+		"nickname": {
+			name:        "nickname <pokemon> <nickname>",
+			description: "Gives one of your Pokemon a nickname",
+			callback:    commandNickname,
+		},
+		"release": {
+			name:        "release <pokemon>",
+			description: "Releases one of your Pokemon back into the wild",
+			callback:    commandRelease,
+		},
+		"battle": {
+			name:        "battle [pokemon]",
+			description: "Fights the wild Pokemon you encountered with your lead Pokemon or the one given",
+			callback:    commandBattle,
+		},
+		"heal": {
+			name:        "heal",
+			description: "Restores the HP of the Pokemon in your party",
+			callback:    commandHeal,
+		},
+		"bag": {
+			name:        "bag",
+			description: "Displays the items in your bag",
+			callback:    commandBag,
+		},
+		"item": {
+			name:        "item <item_name>",
+			description: "Shows an item's category, price and effect",
+			callback:    commandItem,
+		},
+		"use": {
+			name:        "use <item> <pokemon>",
+			description: "Uses an item from your bag on one of your Pokemon",
+			callback:    commandUse,
+		},
+		"shop": {
+			name:        "shop",
+			description: "Lists the items sold at the Poke Mart of the explored location",
+			callback:    commandShop,
+		},
+		"buy": {
+			name:        "buy <item> [quantity]",
+			description: "Buys items at the Poke Mart",
+			callback:    commandBuy,
+		},
+		"sell": {
+			name:        "sell <item> [quantity]",
+			description: "Sells items from your bag at the Poke Mart for half their price",
+			callback:    commandSell,
+		},
+		"plant": {
+			name:        "plant <berry>",
+			description: "Plants a berry from your bag in a soil plot at the explored location",
+			callback:    commandPlant,
+		},
+		"harvest": {
+			name:        "harvest",
+			description: "Picks the ripe berries at the explored location",
+			callback:    commandHarvest,
+		},
+		"plots": {
+			name:        "plots",
+			description: "Displays the berries you planted and when they will be ripe",
+			callback:    commandPlots,
+		},
+		"set": {
+			name:        "set <setting> <value>",
+			description: "Changes a setting: output (table, json, yaml, csv), mode (game, reference), version (a game or all) or language (a language code), e.g. set output json",
+			callback:    commandSet,
+		},
 	}
 }
 
 /*
 startRepl initializes and runs the REPL (Read-Eval-Print Loop) for the Pokedex CLI.
 It continuously reads user input, processes commands, and executes corresponding functions.
+It returns when the input ends. The prompt is only shown when stdin is a terminal,
+so piped input produces clean output. Progress is saved after every line.
 */
 func startRepl(cfg *config) {
-	scanner := bufio.NewScanner(os.Stdin)
+	interactive := render.IsTerminal(os.Stdin)
 	for {
-		fmt.Print("Pokedex > ")
-		scanner.Scan()
+		if interactive {
+			fmt.Print("Pokedex > ")
+		}
+		if !cfg.input.Scan() {
+			return
+		}
 
-		words := cleanInput(scanner.Text())
-		if len(words) == 0 {
+		lines, err := tokenize(cfg.input.Text())
+		if err != nil {
+			fmt.Fprintln(os.Stderr, cfg.msg.Error(err))
 			continue
 		}
 
-		commandWord := words[0]
-		args := []string{}
-		if len(words) > 1 {
-			args = words[1:]
+		for _, words := range lines {
+			if err := runCommand(cfg, words); err != nil {
+				fmt.Fprintln(os.Stderr, cfg.msg.Error(err))
+			}
+		}
+		if err := saveGame(cfg); err != nil {
+			fmt.Fprintln(os.Stderr, cfg.msg.Error(messages.Errorf("can't save the game: %w", err)))
+		}
+	}
+}
+
+/*
+runCommand looks up the command named by the first word, separates its flags
+from its positional arguments and invokes its callback.
+*/
+func runCommand(cfg *config, words []string) error {
+	commandWord := strings.ToLower(words[0])
+	command, exists := getCommands()[commandWord]
+	if !exists {
+		return messages.Errorf("unknown command %q", words[0])
+	}
+
+	args, flags, err := parseArgs(words[1:], command.flags)
+	if err != nil {
+		return err
+	}
+	return command.callback(cfg, flags, args...)
+}
+
+/*
+prompt asks the player a question in the middle of a command and returns the
+trimmed answer. It returns false when there is no more input.
+*/
+func prompt(cfg *config, question string) (string, bool) {
+	fmt.Print(question)
+	if cfg.input == nil || !cfg.input.Scan() {
+		fmt.Println()
+		return "", false
+	}
+	return strings.TrimSpace(cfg.input.Text()), true
+}
+
+// flagSet holds the flags given to a command, keyed by flag name. Boolean
+// flags are stored with an empty value.
+type flagSet map[string]string
+
+// has reports whether the flag was given.
+func (f flagSet) has(name string) bool {
+	_, ok := f[name]
+	return ok
+}
+
+// get returns the value of the flag, or an empty string if it wasn't given.
+func (f flagSet) get(name string) string {
+	return f[name]
+}
+
+// getInt returns the value of the flag as an integer, or def if it wasn't given.
+func (f flagSet) getInt(name string, def int) (int, error) {
+	val, ok := f[name]
+	if !ok {
+		return def, nil
+	}
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, messages.Errorf("flag --%s expects a number, got %q", name, val)
+	}
+	return n, nil
+}
+
+/*
+tokenize splits a line of user input into words, shell style.
+Words are separated by whitespace and commands are separated by ';', so the
+result holds one slice of words per command. Single quotes preserve their
+contents literally, double quotes allow \" and \\ escapes, and outside of
+quotes a backslash escapes the next character. Case is preserved.
+Returns an error on an unterminated quote or a trailing backslash.
+*/
+func tokenize(text string) ([][]string, error) {
+	commands := [][]string{}
+	words := []string{}
+	var word strings.Builder
+	inWord := false
+
+	endWord := func() {
+		if inWord {
+			words = append(words, word.String())
+			word.Reset()
+			inWord = false
 		}
+	}
+	endCommand := func() {
+		endWord()
+		if len(words) > 0 {
+			commands = append(commands, words)
+			words = []string{}
+		}
+	}
 
-		command, exists := getCommands()[commandWord]
-		if exists {
-			err := command.callback(cfg, args...)
-			if err != nil {
-				fmt.Println(err)
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == ';':
+			endCommand()
+		case unicode.IsSpace(r):
+			endWord()
+		case r == '\\':
+			if i+1 >= len(runes) {
+				return nil, messages.Errorf("trailing backslash in input")
 			}
+			i++
+			word.WriteRune(runes[i])
+			inWord = true
+		case r == '\'':
+			for i++; i < len(runes) && runes[i] != '\''; i++ {
+				word.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, messages.Errorf("unterminated single quote in input")
+			}
+			inWord = true
+		case r == '"':
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+					i++
+				}
+				word.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, messages.Errorf("unterminated double quote in input")
+			}
+			inWord = true
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	endCommand()
+
+	return commands, nil
+}
+
+/*
+parseArgs separates a command's flags from its positional arguments.
+Flags are written as --name=value or --name value; the accepted map tells
+which flags exist and whether they take a value. A bare "--" ends flag parsing.
+Returns the positional arguments, the parsed flags, and an error for
+unknown flags or missing values.
+*/
+func parseArgs(words []string, accepted map[string]bool) ([]string, flagSet, error) {
+	args := []string{}
+	flags := flagSet{}
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if word == "--" {
+			args = append(args, words[i+1:]...)
+			break
+		}
+		if !strings.HasPrefix(word, "--") {
+			args = append(args, word)
 			continue
-		} else {
-			fmt.Println("Unknown command")
-			continue
 		}
+
+		name, value, hasValue := strings.Cut(word[2:], "=")
+		name = strings.ToLower(name)
+		takesValue, ok := accepted[name]
+		if !ok {
+			return nil, nil, messages.Errorf("unknown flag --%s", name)
+		}
+		if takesValue && !hasValue {
+			if i+1 >= len(words) {
+				return nil, nil, messages.Errorf("flag --%s requires a value", name)
+			}
+			i++
+			value = words[i]
+		}
+		if !takesValue && hasValue {
+			return nil, nil, messages.Errorf("flag --%s doesn't take a value", name)
+		}
+		flags[name] = value
 	}
+	return args, flags, nil
 }
 
 /*
-cleanInput processes the user input by converting it to lowercase,
-trimming spaces, and splitting it into individual words.
-Returns a slice of cleaned words.
+toSlug turns user input into a PokeAPI resource identifier by lowercasing it
+and joining its words with dashes, so "Sunyshore City Area" becomes
+"sunyshore-city-area".
 */
-func cleanInput(text string) []string {
-	lowered := strings.ToLower(text)
-	trimmed := strings.TrimSpace(lowered)
-	return strings.Fields(trimmed)
+func toSlug(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), "-")
 }
@@ -5,77 +5,154 @@ import (
 	"testing"
 )
 
-func TestCleanInput(t *testing.T) {
+func TestTokenize(t *testing.T) {
 	cases := []struct {
 		input    string
-		expected []string
+		expected [][]string
 	}{
 		{
 			input:    "  hello  world  ",
-			expected: []string{"hello", "world"},
+			expected: [][]string{{"hello", "world"}},
 		},
 		{
-			input:    "This is a simple test case.",
-			expected: []string{"this", "is", "a", "simple", "test", "case."},
+			input:    "This Is Another Simple Test Case.",
+			expected: [][]string{{"This", "Is", "Another", "Simple", "Test", "Case."}},
 		},
 		{
 			input:    "",
-			expected: []string{},
+			expected: [][]string{},
 		},
 		{
-			input:    "This Is Another Simple Test Case.",
-			expected: []string{"this", "is", "another", "simple", "test", "case."},
+			input:    "    Test   CRAZY    tEsT case,      that   has  ALL tyPEs   ",
+			expected: [][]string{{"Test", "CRAZY", "tEsT", "case,", "that", "has", "ALL", "tyPEs"}},
+		},
+		{
+			input:    `explore "Sunyshore City Area"`,
+			expected: [][]string{{"explore", "Sunyshore City Area"}},
 		},
 		{
-			input:    "ALL CAPS TEST CASE",
-			expected: []string{"all", "caps", "test", "case"},
+			input:    `nickname 3 'Sparky the "Great"'`,
+			expected: [][]string{{"nickname", "3", `Sparky the "Great"`}},
 		},
 		{
-			input:    "    Test   CRAZY    tEsT case,      that   has  ALL tyPEs   of WEirD   stuff GOIng    on.     ",
-			expected: []string{"test", "crazy", "test", "case,", "that", "has", "all", "types", "of", "weird", "stuff", "going", "on."},
+			input:    `say "a \"quoted\" word" back\ slash`,
+			expected: [][]string{{"say", `a "quoted" word`, "back slash"}},
+		},
+		{
+			input:    `export --file="My Pokedex.json"`,
+			expected: [][]string{{"export", "--file=My Pokedex.json"}},
+		},
+		{
+			input:    "explore pastoria-city-area; catch Pikachu ;; pokedex",
+			expected: [][]string{{"explore", "pastoria-city-area"}, {"catch", "Pikachu"}, {"pokedex"}},
+		},
+		{
+			input:    `catch "mr;mime" ""`,
+			expected: [][]string{{"catch", "mr;mime", ""}},
 		},
 	}
 
-	passCount := 0
-	failCount := 0
-
 	for _, c := range cases {
-		failed := 0
-		actual := cleanInput(c.input)
-		if !reflect.DeepEqual(len(actual), len(c.expected)) {
-			failCount++
-			t.Errorf(`---------------------------------
-Text:     %v
-Expecting: %+v
-Actual:   %+v
-Fail`, c.input, c.expected, actual)
+		actual, err := tokenize(c.input)
+		if err != nil {
+			t.Errorf("tokenize(%q): unexpected error: %v", c.input, err)
 			continue
 		}
-		for i := range actual {
-			word := actual[i]
-			expectedWord := c.expected[i]
-			if !reflect.DeepEqual(word, expectedWord) {
-				failed++
-				failCount++
-				t.Errorf(`---------------------------------
-Text:     %v
-Expecting: %+v
-Actual:   %+v
-Fail`, c.input, c.expected, actual)
-				break
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("tokenize(%q)\nExpecting: %q\nActual:    %q", c.input, c.expected, actual)
+		}
+	}
+}
+
+func TestTokenizeErrors(t *testing.T) {
+	cases := []string{
+		`catch "pikachu`,
+		`catch 'pikachu`,
+		`catch pikachu\`,
+	}
+
+	for _, input := range cases {
+		if _, err := tokenize(input); err == nil {
+			t.Errorf("tokenize(%q): expected an error", input)
+		}
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+	accepted := map[string]bool{
+		"limit": true,
+		"desc":  false,
+	}
+	cases := []struct {
+		words         []string
+		expectedArgs  []string
+		expectedFlags flagSet
+		expectErr     bool
+	}{
+		{
+			words:         []string{"pikachu"},
+			expectedArgs:  []string{"pikachu"},
+			expectedFlags: flagSet{},
+		},
+		{
+			words:         []string{"--limit=5", "pikachu", "--desc"},
+			expectedArgs:  []string{"pikachu"},
+			expectedFlags: flagSet{"limit": "5", "desc": ""},
+		},
+		{
+			words:         []string{"--LIMIT", "10", "a", "--", "--desc"},
+			expectedArgs:  []string{"a", "--desc"},
+			expectedFlags: flagSet{"limit": "10"},
+		},
+		{
+			words:     []string{"--limit"},
+			expectErr: true,
+		},
+		{
+			words:     []string{"--desc=yes"},
+			expectErr: true,
+		},
+		{
+			words:     []string{"--unknown"},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		args, flags, err := parseArgs(c.words, accepted)
+		if c.expectErr {
+			if err == nil {
+				t.Errorf("parseArgs(%q): expected an error", c.words)
 			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseArgs(%q): unexpected error: %v", c.words, err)
+			continue
+		}
+		if !reflect.DeepEqual(args, c.expectedArgs) || !reflect.DeepEqual(flags, c.expectedFlags) {
+			t.Errorf("parseArgs(%q)\nExpecting: %q %q\nActual:    %q %q", c.words, c.expectedArgs, c.expectedFlags, args, flags)
 		}
-		if failed == 0 {
-			passCount++
-			t.Logf(`---------------------------------
-Text:		%v
-Expecting:  %+v
-Actual:     %+v
-Pass
-`, c.input, c.expected, actual)
+	}
+}
+
+func TestToSlug(t *testing.T) {
+	cases := map[string]string{
+		"Pikachu":               "pikachu",
+		"  Sunyshore City Area": "sunyshore-city-area",
+		"great-ball":            "great-ball",
+	}
+
+	for input, expected := range cases {
+		if actual := toSlug(input); actual != expected {
+			t.Errorf("toSlug(%q) = %q, expected %q", input, actual, expected)
 		}
 	}
+}
 
-	t.Logf("---------------------------------")
-	t.Logf("%d passed, %d failed\n", passCount, failCount)
+func TestRunCommandUnknown(t *testing.T) {
+	err := runCommand(&config{}, []string{"Fly", "cerulean-city"})
+	if err == nil || err.Error() != `unknown command "Fly"` {
+		t.Errorf(`expected the error unknown command "Fly", got %v`, err)
+	}
 }
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// loadWorkers is the number of caught Pokemon fetched in parallel when a game is loaded.
+const loadWorkers = 8
+
+// saveData is the player's progress as it is written to the save file.
+// Caught Pokemon are saved by name and fetched again when the game is loaded.
+type saveData struct {
+	Caught  []string      `json:"caught"`
+	Storage *game.Storage `json:"storage"`
+	Bag     game.Bag      `json:"bag"`
+	Money   int           `json:"money"`
+	Farm    *game.Farm    `json:"farm"`
+	Pokedex *game.Pokedex `json:"pokedex"`
+}
+
+/*
+saveGame writes the player's progress to the save file. The file is replaced
+in one step, so an interrupted save never leaves a broken file behind.
+Nothing is saved when no save file is configured.
+*/
+func saveGame(cfg *config) error {
+	if cfg.savePath == "" {
+		return nil
+	}
+
+	data := saveData{
+		Caught:  []string{},
+		Storage: cfg.storage,
+		Bag:     cfg.bag,
+		Money:   cfg.money,
+		Farm:    cfg.farm,
+		Pokedex: cfg.pokedex,
+	}
+	for name := range cfg.caughtPokemon {
+		data.Caught = append(data.Caught, name)
+	}
+	data.Caught = append(data.Caught, cfg.unloadedCaught...)
+	slices.Sort(data.Caught)
+	data.Caught = slices.Compact(data.Caught)
+
+	contents, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(cfg.savePath), 0o755); err != nil {
+		return err
+	}
+	tmp := cfg.savePath + ".tmp"
+	if err := os.WriteFile(tmp, contents, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, cfg.savePath)
+}
+
+/*
+loadGame restores the player's progress from the save file, fetching the data
+of the caught Pokemon from the PokeAPI. A missing save file starts a new game.
+Caught Pokemon that can't be fetched don't stop the game from loading: a
+warning is printed and they stay in the save file, to be loaded next time.
+*/
+func loadGame(cfg *config) error {
+	if cfg.savePath == "" {
+		return nil
+	}
+	contents, err := os.ReadFile(cfg.savePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	data := saveData{}
+	if err := json.Unmarshal(contents, &data); err != nil {
+		return messages.Errorf("can't read the save file %s: %w", cfg.savePath, err)
+	}
+
+	caught, err := fetchPokemon(cfg, data.Caught)
+	cfg.caughtPokemon = caught
+	if err != nil {
+		cfg.unloadedCaught = slices.DeleteFunc(slices.Clone(data.Caught), func(name string) bool {
+			_, ok := caught[name]
+			return ok
+		})
+		fmt.Fprint(os.Stderr, cfg.msg.Sprintf("warning: can't load %d of your caught Pokemon, they'll be loaded next time: %s\n", len(cfg.unloadedCaught), cfg.msg.Error(err)))
+	}
+	if data.Storage != nil {
+		cfg.storage = data.Storage
+	}
+	if data.Bag != nil {
+		cfg.bag = data.Bag
+	}
+	if data.Farm != nil {
+		cfg.farm = data.Farm
+	}
+	if data.Pokedex != nil {
+		cfg.pokedex = data.Pokedex
+	}
+	cfg.money = data.Money
+	return nil
+}
+
+// fetchPokemon fetches the data of several Pokemon in parallel.
+func fetchPokemon(cfg *config, names []string) (map[string]pokeapi.Pokemon, error) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+	result := map[string]pokeapi.Pokemon{}
+	sem := make(chan struct{}, loadWorkers)
+	for _, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			pokemon, err := cfg.pokeapiClient.GetPokemon(name)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s: %w", name, err)
+				}
+				return
+			}
+			result[name] = pokemon
+		}()
+	}
+	wg.Wait()
+	return result, firstErr
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+func TestSaveAndLoadGame(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pokedexcli", "save.json")
+	cfg := &config{
+		caughtPokemon: map[string]pokeapi.Pokemon{},
+		storage:       game.NewStorage(),
+		bag:           game.Bag{"great-ball": 2},
+		money:         1234,
+		farm:          game.NewFarm(),
+		pokedex:       game.NewPokedex(),
+		savePath:      path,
+	}
+	cfg.pokedex.See("staryu", 120)
+	if _, err := cfg.storage.Add(&game.Pokemon{Species: "pikachu", Nickname: "Sparky", Level: 12}); err != nil {
+		t.Fatal(err)
+	}
+	planted := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	if _, err := cfg.farm.Plant("pallet-town", pokeapi.Berry{Name: "oran", GrowthTime: 4, Item: pokeapi.Resource{Name: "oran-berry"}}, planted); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveGame(cfg); err != nil {
+		t.Fatalf("unexpected error saving: %v", err)
+	}
+
+	loaded := &config{
+		caughtPokemon: map[string]pokeapi.Pokemon{},
+		storage:       game.NewStorage(),
+		bag:           game.StarterBag(),
+		money:         game.StartingMoney,
+		farm:          game.NewFarm(),
+		pokedex:       game.NewPokedex(),
+		savePath:      path,
+	}
+	if err := loadGame(loaded); err != nil {
+		t.Fatalf("unexpected error loading: %v", err)
+	}
+	if p, box := loaded.storage.Find(1); p == nil || box != 0 || p.Nickname != "Sparky" || p.Level != 12 {
+		t.Errorf("expected Sparky in the party, got %+v in box %d", p, box)
+	}
+	if loaded.bag["great-ball"] != 2 || loaded.bag["poke-ball"] != 0 || loaded.money != 1234 {
+		t.Errorf("expected the saved bag and money, got %v and %d", loaded.bag, loaded.money)
+	}
+	if plots := loaded.farm.At("pallet-town"); len(plots) != 1 || !plots[0].RipeAt().Equal(planted.Add(16*time.Hour)) {
+		t.Errorf("expected the planted berry to be loaded, got %+v", loaded.farm.Plots)
+	}
+	if !loaded.pokedex.HasSeen(120) {
+		t.Errorf("expected staryu to be seen, got %v", loaded.pokedex.Seen)
+	}
+
+	missing := &config{savePath: filepath.Join(t.TempDir(), "none.json"), money: game.StartingMoney}
+	if err := loadGame(missing); err != nil || missing.money != game.StartingMoney {
+		t.Errorf("expected a missing save file to start a new game, got %v", err)
+	}
+}
+
+func TestLoadGameWithUnreachableAPI(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	saved := &config{
+		caughtPokemon: map[string]pokeapi.Pokemon{"pikachu": {Name: "pikachu"}},
+		storage:       game.NewStorage(),
+		bag:           game.Bag{"poke-ball": 3},
+		money:         500,
+		savePath:      path,
+	}
+	if err := saveGame(saved); err != nil {
+		t.Fatal(err)
+	}
+
+	// A client that times out at once stands in for a network failure.
+	loaded := &config{
+		caughtPokemon: map[string]pokeapi.Pokemon{},
+		storage:       game.NewStorage(),
+		savePath:      path,
+		pokeapiClient: pokeapi.NewClient(time.Nanosecond, time.Minute, t.TempDir()),
+	}
+	if err := loadGame(loaded); err != nil {
+		t.Fatalf("expected the game to load without its Pokemon data, got %v", err)
+	}
+	if loaded.money != 500 || loaded.bag["poke-ball"] != 3 {
+		t.Errorf("expected the saved bag and money, got %v and %d", loaded.bag, loaded.money)
+	}
+	if len(loaded.caughtPokemon) != 0 || len(loaded.unloadedCaught) != 1 {
+		t.Fatalf("expected pikachu to be left unloaded, got %v and %v", loaded.caughtPokemon, loaded.unloadedCaught)
+	}
+
+	// Saving again keeps the Pokemon that couldn't be loaded.
+	if err := saveGame(loaded); err != nil {
+		t.Fatal(err)
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(contents), `"pikachu"`) {
+		t.Errorf("expected pikachu to stay in the save file, got %s", contents)
+	}
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+// maxTradeQuantity is the most items that can be bought or sold at once, as in
+// the games. It also keeps the price of a purchase from overflowing.
+const maxTradeQuantity = 999
+
+// currentMart returns the name of the location explored last and the items
+// its Poke Mart sells. It fails when there is no Poke Mart there.
+func currentMart(cfg *config) (string, []string, error) {
+	name, err := exploredLocation(cfg)
+	if err != nil {
+		return "", nil, err
+	}
+	location, err := cfg.pokeapiClient.GetLocationByName(name)
+	if err != nil {
+		return "", nil, err
+	}
+	stock := game.MartStock(location.Region.Name, location.Name)
+	if stock == nil {
+		return "", nil, messages.Errorf("there's no Poke Mart in %s. Poke Marts are in towns and cities", location.Name)
+	}
+	return location.Name, stock, nil
+}
+
+// formatMoney writes an amount of money in Pokedollars.
+func formatMoney(amount int) string {
+	return "₽" + strconv.Itoa(amount)
+}
+
+/*
+commandShop lists the items sold by the Poke Mart at the explored location,
+with their prices and how many the player has.
+*/
+func commandShop(cfg *config, flags flagSet, args ...string) error {
+	location, stock, err := currentMart(cfg)
+	if err != nil {
+		return err
+	}
+
+	records := []shopRecord{}
+	for _, name := range stock {
+		item, err := cfg.pokeapiClient.GetItem(name)
+		if err != nil {
+			return err
+		}
+		records = append(records, shopRecord{Item: item.Name, Price: item.Cost, InBag: cfg.bag[item.Name]})
+	}
+	return printRecords(cfg, records, func() {
+		cfg.msg.Printf("Welcome to the %s Poke Mart! You have %s.\n", location, formatMoney(cfg.money))
+		rows := [][]string{}
+		for _, r := range records {
+			rows = append(rows, []string{r.Item, formatMoney(r.Price), strconv.Itoa(r.InBag)})
+		}
+		cfg.ui.Table([]string{cfg.msg.Sprintf("Item"), cfg.msg.Sprintf("Price"), cfg.msg.Sprintf("In bag")}, rows)
+	})
+}
+
+// tradeArgs parses the item and optional quantity given to a command, buy or sell.
+func tradeArgs(command string, args []string) (string, int, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return "", 0, messages.Errorf("usage: %s <item> [quantity]", command)
+	}
+	qty := 1
+	if len(args) == 2 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			return "", 0, messages.Errorf("the quantity must be a positive number, got %q", args[1])
+		}
+		if n > maxTradeQuantity {
+			return "", 0, messages.Errorf("you can trade at most %d items at once", maxTradeQuantity)
+		}
+		qty = n
+	}
+	return toSlug(args[0]), qty, nil
+}
+
+/*
+commandBuy buys items from the Poke Mart at the explored location and puts
+them in the bag.
+*/
+func commandBuy(cfg *config, flags flagSet, args ...string) error {
+	name, qty, err := tradeArgs("buy", args)
+	if err != nil {
+		return err
+	}
+	location, stock, err := currentMart(cfg)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(stock, name) {
+		return messages.Errorf("the %s Poke Mart doesn't sell %s. see what it sells with the shop command", location, name)
+	}
+	item, err := cfg.pokeapiClient.GetItem(name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointItem, name, err)
+	}
+
+	total := item.Cost * qty
+	if total > cfg.money {
+		return messages.Errorf("%d %s cost %s, but you only have %s", qty, name, formatMoney(total), formatMoney(cfg.money))
+	}
+	cfg.money -= total
+	cfg.bag.Add(name, qty)
+	cfg.msg.Printf("You bought %d %s for %s. You have %s left.\n", qty, name, formatMoney(total), formatMoney(cfg.money))
+	return nil
+}
+
+/*
+commandSell sells items from the bag to the Poke Mart at the explored
+location for half their price.
+*/
+func commandSell(cfg *config, flags flagSet, args ...string) error {
+	name, qty, err := tradeArgs("sell", args)
+	if err != nil {
+		return err
+	}
+	if cfg.bag[name] < qty {
+		return messages.Errorf("you only have %d %s", cfg.bag[name], name)
+	}
+	if _, _, err := currentMart(cfg); err != nil {
+		return err
+	}
+	item, err := cfg.pokeapiClient.GetItem(name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointItem, name, err)
+	}
+
+	price := game.SellPrice(item.Cost)
+	if price == 0 {
+		return messages.Errorf("%s can't be sold", name)
+	}
+	if err := cfg.bag.Remove(name, qty); err != nil {
+		return err
+	}
+	cfg.money += price * qty
+	cfg.msg.Printf("You sold %d %s for %s. You have %s now.\n", qty, name, formatMoney(price*qty), formatMoney(cfg.money))
+	return nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import "testing"
+
+func TestTradeArgs(t *testing.T) {
+	cases := []struct {
+		args    []string
+		qty     int
+		wantErr bool
+	}{
+		{args: []string{"Poke Ball"}, qty: 1},
+		{args: []string{"poke-ball", "999"}, qty: 999},
+		{args: []string{"poke-ball", "1000"}, wantErr: true},
+		// Multiplied by a price, this quantity would overflow into negative money.
+		{args: []string{"poke-ball", "46116860184273880"}, wantErr: true},
+		{args: []string{"poke-ball", "0"}, wantErr: true},
+		{args: []string{"poke-ball", "-3"}, wantErr: true},
+		{args: []string{}, wantErr: true},
+	}
+	for _, c := range cases {
+		name, qty, err := tradeArgs("buy", c.args)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("%v: expected an error, got %d", c.args, qty)
+			}
+			continue
+		}
+		if err != nil || name != "poke-ball" || qty != c.qty {
+			t.Errorf("%v: expected poke-ball x%d, got %s x%d (%v)", c.args, c.qty, name, qty, err)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/OferRavid/pokedexcli/internal/game"
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+	"github.com/OferRavid/pokedexcli/internal/render"
+)
+
+// allVersions is the version name that stops limiting the CLI to one game.
+const allVersions = "all"
+
+// gameVersion is the game the CLI is limited to, chosen with the version
+// command. The zero value stands for every game.
+type gameVersion struct {
+	name         string   // what the player chose: a version or a version group
+	versions     []string // the versions it covers
+	versionGroup string
+	order        int // the version group's order, newer games being higher
+	generation   string
+}
+
+// String describes the game, e.g. "diamond (diamond-pearl, generation-iv)".
+func (g gameVersion) String() string {
+	if g.name == "" {
+		return allVersions
+	}
+	if g.name == g.versionGroup {
+		return fmt.Sprintf("%s (%s, %s)", g.name, strings.Join(g.versions, ", "), g.generation)
+	}
+	return fmt.Sprintf("%s (%s, %s)", g.name, g.versionGroup, g.generation)
+}
+
+// matchesVersion reports whether a version is one of the given versions.
+// Every version matches when none are given.
+func matchesVersion(versions []string, version string) bool {
+	return len(versions) == 0 || slices.Contains(versions, version)
+}
+
+// versionFilter returns the versions a command is limited to: the one given
+// with --version, or those of the chosen game. nil means every version.
+func versionFilter(cfg *config, flags flagSet) []string {
+	if version := toSlug(flags.get("version")); version != "" {
+		return []string{version}
+	}
+	return cfg.game.versions
+}
+
+/*
+findGameVersion looks up a game by the name of a version, e.g. "diamond", or
+of a version group, e.g. "diamond-pearl", with the version group and
+generation it belongs to.
+*/
+func findGameVersion(cfg *config, name string) (gameVersion, error) {
+	version, err := cfg.pokeapiClient.GetVersion(name)
+	if err == nil {
+		group, err := cfg.pokeapiClient.GetVersionGroup(version.VersionGroup.Name)
+		if err != nil {
+			return gameVersion{}, err
+		}
+		return gameVersion{
+			name:         version.Name,
+			versions:     []string{version.Name},
+			versionGroup: group.Name,
+			order:        group.Order,
+			generation:   group.Generation.Name,
+		}, nil
+	}
+	if !errors.Is(err, pokeapi.ErrNotFound) {
+		return gameVersion{}, err
+	}
+
+	group, err := cfg.pokeapiClient.GetVersionGroup(name)
+	if err != nil {
+		return gameVersion{}, notFoundError(cfg, pokeapi.EndpointVersion, name, err)
+	}
+	g := gameVersion{name: group.Name, versionGroup: group.Name, order: group.Order, generation: group.Generation.Name}
+	for _, v := range group.Versions {
+		g.versions = append(g.versions, v.Name)
+	}
+	return g, nil
+}
+
+// setGameVersion limits the CLI to a game, or to every game for "all".
+func setGameVersion(cfg *config, name string) error {
+	name = toSlug(name)
+	if name == allVersions {
+		cfg.game = gameVersion{}
+		fmt.Println(cfg.msg.Sprintf("Showing data from every game."))
+		return nil
+	}
+	g, err := findGameVersion(cfg, name)
+	if err != nil {
+		return err
+	}
+	cfg.game = g
+	cfg.msg.Printf("Showing data from %s.\n", g)
+	return nil
+}
+
+/*
+commandVersion limits encounters, learnsets, type matchups and flavor texts
+to one game, given as a version or a version group. "all" shows every game
+again, and without arguments the current game is shown.
+*/
+func commandVersion(cfg *config, flags flagSet, args ...string) error {
+	switch len(args) {
+	case 0:
+		cfg.msg.Printf("version: %s\n", cfg.game)
+		return nil
+	case 1:
+		return setGameVersion(cfg, args[0])
+	default:
+		return messages.Errorf("usage: version [<game>|all]")
+	}
+}
+
+// gameTypes returns a Pokemon's types in the chosen game's generation.
+func gameTypes(cfg *config, pokemon pokeapi.Pokemon) []string {
+	return game.TypesIn(pokemon, cfg.game.generation)
+}
+
+// gameMove returns a move with the power, accuracy, PP and type it had in the
+// chosen game, looking up the version groups in which it changed.
+func gameMove(cfg *config, move pokeapi.Move) (pokeapi.Move, error) {
+	if cfg.game.name == "" {
+		return move, nil
+	}
+	orders := map[string]int{}
+	for _, past := range move.PastValues {
+		group, err := cfg.pokeapiClient.GetVersionGroup(past.VersionGroup.Name)
+		if err != nil {
+			return pokeapi.Move{}, err
+		}
+		orders[group.Name] = group.Order
+	}
+	return game.MoveIn(move, orders, cfg.game.order), nil
+}
+
+/*
+flavorText returns the in-game description from the chosen game in the given
+language, or in the default language when there is none. Species are
+described per version and other resources per version group. Without a
+chosen game the newest description is used; when the chosen game has none,
+it returns "".
+*/
+func flavorText(entries []pokeapi.FlavorText, language string, g gameVersion) string {
+	found, foundLanguage := "", ""
+	for _, entry := range entries {
+		if entry.Language.Name != language && entry.Language.Name != defaultLanguage {
+			continue
+		}
+		if g.name != "" {
+			inVersion := entry.Version != nil && slices.Contains(g.versions, entry.Version.Name)
+			inGroup := entry.VersionGroup != nil && entry.VersionGroup.Name == g.versionGroup
+			if !inVersion && !inGroup {
+				continue
+			}
+		}
+		// The entries are listed from the oldest game to the newest.
+		if entry.Language.Name == language || foundLanguage != language {
+			found, foundLanguage = entry.FlavorText, entry.Language.Name
+		}
+	}
+	return strings.Join(strings.Fields(found), " ")
+}
+
+// printSpeciesEntry prints the pokedex entry of a Pokemon's species from the
+// chosen game. It is optional, so errors fetching the species are ignored.
+func printSpeciesEntry(cfg *config, pokemon pokeapi.Pokemon) {
+	species, err := cfg.pokeapiClient.GetPokemonSpecies(pokemon.Species.Name)
+	if err != nil {
+		return
+	}
+	if text := flavorText(species.FlavorTextEntries, cfg.language, cfg.game); text != "" {
+		fmt.Println(cfg.ui.Colorize(render.Gray, text))
+	}
+}
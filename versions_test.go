@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+func TestFlavorText(t *testing.T) {
+	entry := func(text, language, version string) pokeapi.FlavorText {
+		return pokeapi.FlavorText{
+			FlavorText: text,
+			Language:   pokeapi.Resource{Name: language},
+			Version:    &pokeapi.Resource{Name: version},
+		}
+	}
+	entries := []pokeapi.FlavorText{
+		entry("Old\nentry.", "en", "red"),
+		entry("Ancienne entrée.", "fr", "red"),
+		entry("Diamond\fentry.", "en", "diamond"),
+		entry("Newest entry.", "en", "sword"),
+	}
+	diamond := gameVersion{name: "diamond", versions: []string{"diamond"}, versionGroup: "diamond-pearl"}
+	cases := []struct {
+		language string
+		game     gameVersion
+		expected string
+	}{
+		{language: "en", game: gameVersion{}, expected: "Newest entry."},
+		{language: "en", game: diamond, expected: "Diamond entry."},
+		{language: "fr", game: gameVersion{name: "red", versions: []string{"red"}}, expected: "Ancienne entrée."},
+		{language: "fr", game: diamond, expected: "Diamond entry."},
+		{language: "en", game: gameVersion{name: "x", versions: []string{"x"}}, expected: ""},
+	}
+	for _, c := range cases {
+		if got := flavorText(entries, c.language, c.game); got != c.expected {
+			t.Errorf("%s in %s: expected %q, got %q", c.language, c.game, c.expected, got)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/OferRavid/pokedexcli/internal/messages"
+	"github.com/OferRavid/pokedexcli/internal/pokeapi"
+)
+
+/*
+commandWhere lists the location areas where a Pokemon can be encountered,
+with the game versions, encounter methods, level ranges and chances, so the
+player knows where to explore next. --version and --method limit the list to
+one game and one encounter method.
+*/
+func commandWhere(cfg *config, flags flagSet, args ...string) error {
+	if len(args) != 1 {
+		return messages.Errorf("you must provide a pokemon name")
+	}
+	versions := versionFilter(cfg, flags)
+	method := toSlug(flags.get("method"))
+
+	name := toSlug(args[0])
+	pokemon, err := cfg.pokeapiClient.GetPokemon(name)
+	if err != nil {
+		return notFoundError(cfg, pokeapi.EndpointPokemon, name, err)
+	}
+	encounters, err := cfg.pokeapiClient.GetPokemonEncounters(pokemon.LocationAreaEncounters)
+	if err != nil {
+		return err
+	}
+
+	slots := pokemonSlots(pokemon, encounters, versions, method)
+	if len(slots) == 0 {
+		if len(encounters) > 0 {
+			return messages.Errorf("%s can't be found that way. try without --version and --method", pokemon.Name)
+		}
+		return messages.Errorf("%s can't be found in the wild", pokemon.Name)
+	}
+	records := []encounterDetailRecord{}
+	for _, slot := range slots {
+		records = append(records, newEncounterDetailRecord(slot.area, slot))
+	}
+	return printRecords(cfg, records, func() {
+		cfg.msg.Printf("%s can be found in:\n", pokemonName(cfg, pokemon.Name))
+		areas := []string{}
+		for _, slot := range slots {
+			areas = append(areas, slot.area)
+		}
+		prefetchNames(cfg, pokeapi.EndpointLocationArea, areas)
+
+		rows := [][]string{}
+		for _, slot := range slots {
+			rows = append(rows, []string{displayName(cfg, pokeapi.EndpointLocationArea, slot.area), slot.version, slot.method, slot.levelRange(), fmt.Sprintf("%d%%", slot.chance)})
+		}
+		cfg.ui.Table([]string{cfg.msg.Sprintf("Area"), cfg.msg.Sprintf("Version"), cfg.msg.Sprintf("Method"), cfg.msg.Sprintf("Levels"), cfg.msg.Sprintf("Chance")}, rows)
+	})
+}